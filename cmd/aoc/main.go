@@ -0,0 +1,104 @@
+// Command aoc runs any number of registered day solvers from a single binary.
+//
+//	aoc run 7
+//	aoc run 1-16
+//	aoc run 1,3,5
+//	aoc run --all
+//	aoc run -input my-input.txt 7
+//	aoc run -example 2 12
+//	cat input.txt | aoc run -input - 7
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	_ "github.com/aoc2024/days"
+	"github.com/aoc2024/runner"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc run [flags] [DAY | FROM-TO | DAY,DAY,...]")
+}
+
+// parseDays expands a day selection such as "7", "1-16" or "1,3,5".
+func parseDays(spec string) ([]int, error) {
+	var days []int
+	for _, part := range strings.Split(spec, ",") {
+		from, to, isRange := strings.Cut(part, "-")
+		first, err := strconv.Atoi(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid day %q", part)
+		}
+		last := first
+		if isRange {
+			last, err = strconv.Atoi(to)
+			if err != nil || last < first {
+				return nil, fmt.Errorf("invalid day range %q", part)
+			}
+		}
+		for day := first; day <= last; day++ {
+			days = append(days, day)
+		}
+	}
+	return days, nil
+}
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "run" {
+		usage()
+		os.Exit(runner.ExitUsage)
+	}
+
+	fs := flag.NewFlagSet("run", flag.ExitOnError)
+	fs.Usage = func() {
+		usage()
+		fs.PrintDefaults()
+	}
+	all := fs.Bool("all", false, "run every registered day")
+	in := runner.InputFlags(fs)
+	fs.Parse(os.Args[2:])
+
+	var days []int
+	switch {
+	case *all:
+		days = runner.Days()
+	case fs.NArg() == 1:
+		var err error
+		if days, err = parseDays(fs.Arg(0)); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(runner.ExitUsage)
+		}
+	default:
+		usage()
+		os.Exit(runner.ExitUsage)
+	}
+
+	for _, day := range days {
+		if _, ok := runner.Lookup(day); !ok {
+			fmt.Fprintln(os.Stderr, &runner.UnknownDayError{Day: day})
+			os.Exit(runner.ExitUsage)
+		}
+	}
+
+	if in.Path != "" && len(days) != 1 {
+		fmt.Fprintln(os.Stderr, "-input can only be used with a single day")
+		os.Exit(runner.ExitUsage)
+	}
+
+	// The first failure decides the exit status, the remaining days still run.
+	status := runner.ExitOK
+	for _, day := range days {
+		fmt.Printf("Day %d\n", day)
+		if err := runner.Run(os.Stdout, day, *in); err != nil {
+			fmt.Fprintf(os.Stderr, "Error in day %d: %v\n", day, err)
+			if status == runner.ExitOK {
+				status = runner.ExitCode(err)
+			}
+		}
+	}
+	os.Exit(status)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseDays(t *testing.T) {
+	tests := []struct {
+		spec string
+		want []int // nil for an error
+	}{
+		{"7", []int{7}},
+		{"1-3", []int{1, 2, 3}},
+		{"5-5", []int{5}},
+		{"1,3,5", []int{1, 3, 5}},
+		{"1-2,5", []int{1, 2, 5}},
+		{"3-1", nil},
+		{"1,,3", nil},
+		{"a-b", nil},
+		{"1-", nil},
+		{"-3", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		days, err := parseDays(tt.spec)
+		if tt.want == nil {
+			if err == nil {
+				t.Errorf("parseDays(%q) = %v, want an error", tt.spec, days)
+			}
+		} else if err != nil || !slices.Equal(days, tt.want) {
+			t.Errorf("parseDays(%q) = %v, %v; want %v", tt.spec, days, err, tt.want)
+		}
+	}
+}
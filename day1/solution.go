@@ -0,0 +1,114 @@
+package day1
+
+import (
+	_ "embed"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(1, solver.Func(solve))
+	runner.RegisterExamples(1, fixture.MustParse(examples))
+}
+
+// solve returns the total distance and the similarity score of both lists
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	list1, list2, err := processLines(input)
+	if err != nil {
+		return solver.Result{}, solver.Result{}, fmt.Errorf("processing lines: %w", err)
+	}
+
+	part2 := calculateSimilarity(list1, list2)
+
+	slices.Sort(list1)
+	slices.Sort(list2)
+	part1 := calculateDistance(list1, list2)
+
+	return solver.Int(part1), solver.Int(part2), nil
+}
+
+// parseLocation converts a string to int with error handling
+func parseLocation(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(s)
+}
+
+// processLines parses input lines and returns two lists of locations
+func processLines(lines []string) ([]int, []int, error) {
+	// Pre-allocate slices for better performance
+	list1 := make([]int, 0, len(lines))
+	list2 := make([]int, 0, len(lines))
+
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, nil, &helper.ParseError{Line: i + 1, Err: fmt.Errorf("invalid line format: %s", line)}
+		}
+
+		loc1, err := parseLocation(fields[0])
+		if err != nil {
+			col := strings.Index(line, fields[0]) + 1
+			return nil, nil, &helper.ParseError{Line: i + 1, Col: col, Err: fmt.Errorf("parsing location 1: %w", err)}
+		}
+
+		loc2, err := parseLocation(fields[1])
+		if err != nil {
+			col := strings.LastIndex(line, fields[1]) + 1
+			return nil, nil, &helper.ParseError{Line: i + 1, Col: col, Err: fmt.Errorf("parsing location 2: %w", err)}
+		}
+
+		list1 = append(list1, loc1)
+		list2 = append(list2, loc2)
+	}
+
+	return list1, list2, nil
+}
+
+// calculateDistance computes total distance between sorted lists
+func calculateDistance(list1, list2 []int) int {
+	minLen := len(list1)
+	if len(list2) < minLen {
+		minLen = len(list2)
+	}
+
+	total := 0
+	for i := 0; i < minLen; i++ {
+		total += helper.Abs(list1[i] - list2[i])
+	}
+	return total
+}
+
+// calculateSimilarity multiplies every value by how often it occurs in both lists
+func calculateSimilarity(list1, list2 []int) int {
+	// Maps to store counts for both lists
+	list1Counts := make(map[int]int)
+	list2Counts := make(map[int]int)
+	for _, val := range list1 {
+		list1Counts[val]++
+	}
+	for _, val := range list2 {
+		list2Counts[val]++
+	}
+
+	total := 0
+	// Calculate total by multiplying matching counts
+	for val, count1 := range list1Counts {
+		if count2, exists := list2Counts[val]; exists {
+			total += val * count1 * count2
+		}
+	}
+	return total
+}
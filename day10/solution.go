@@ -0,0 +1,64 @@
+package day10
+
+import (
+	_ "embed"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/helper/graph"
+	"github.com/aoc2024/helper/grid"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(10, solver.Func(solve))
+	runner.RegisterExamples(10, fixture.MustParse(examples))
+}
+
+// uphill yields the neighbors of a position that are exactly one higher,
+// the only steps a hiking trail may take.
+func uphill(matrix *grid.Grid[int]) graph.Neighbors[grid.Point] {
+	return graph.Filter(matrix.Neighbors4, func(from, to grid.Point) bool {
+		return matrix.At(to) == matrix.At(from)+1
+	})
+}
+
+func calculateTotalPaths(matrix *grid.Grid[int]) int {
+	totalScore := 0
+	for _, trailhead := range grid.FindAll(matrix, 0) {
+		for p := range graph.FloodFill(trailhead, uphill(matrix)) {
+			if matrix.At(p) == 9 {
+				totalScore++
+			}
+		}
+	}
+	return totalScore
+}
+
+func countDistinctPaths(matrix *grid.Grid[int]) int {
+	return graph.CountPaths(grid.FindAll(matrix, 0), uphill(matrix), func(p grid.Point) bool {
+		return matrix.At(p) == 9
+	})
+}
+
+func buildMatrix(input []string) *grid.Grid[int] {
+	return grid.Parse(input, func(char rune) int {
+		return int(char - '0')
+	})
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	if len(input) == 0 {
+		return solver.Result{}, solver.Result{}, helper.ErrEmptyInput
+	}
+	part1, part2 := 0, 0
+	matrix := buildMatrix(input)
+	part1 = calculateTotalPaths(matrix)
+	part2 = countDistinctPaths(matrix)
+	return solver.Int(part1), solver.Int(part2), nil
+}
@@ -0,0 +1,45 @@
+package day11
+
+import "math/big"
+
+// bigStones is a set of stones that outgrew a uint64, keyed by their
+// decimal digits.
+type bigStones map[string]*big.Int
+
+func toBig(stones shards) bigStones {
+	out := make(bigStones)
+	for _, shard := range stones {
+		for value, count := range shard {
+			out.add(new(big.Int).SetUint64(value).String(), new(big.Int).SetUint64(count))
+		}
+	}
+	return out
+}
+
+// blinkBig is pool.blink on big integers. It is the fallback for stones or
+// counts that outgrow a uint64, so it runs on a single goroutine.
+func blinkBig(rules []Rule, stones bigStones) bigStones {
+	next := make(bigStones)
+	for digits, count := range stones {
+		for _, d := range processBig(rules, digits) {
+			next.add(d, count)
+		}
+	}
+	return next
+}
+
+func (b bigStones) add(digits string, count *big.Int) {
+	if sum, ok := b[digits]; ok {
+		sum.Add(sum, count)
+		return
+	}
+	b[digits] = new(big.Int).Set(count)
+}
+
+func (b bigStones) total() *big.Int {
+	total := new(big.Int)
+	for _, count := range b {
+		total.Add(total, count)
+	}
+	return total
+}
@@ -0,0 +1,109 @@
+package day11
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/aoc2024/helper"
+)
+
+// Expander answers how many stones a single stone turns into after some
+// number of blinks. Stones never affect each other, so the answer only
+// depends on the stone's number and the blinks left, and every answer is
+// remembered: asking again, or asking about a stone met on the way, costs
+// a map lookup.
+type Expander struct {
+	rules []Rule
+	memo  map[memoKey]uint64
+	// memoBig takes over for stones or counts that outgrow a uint64.
+	memoBig map[memoKeyBig]*big.Int
+}
+
+type memoKey struct {
+	value  uint64
+	blinks int
+}
+
+type memoKeyBig struct {
+	digits string
+	blinks int
+}
+
+// NewExpander returns an Expander applying rules, or DefaultRules when
+// rules is nil.
+func NewExpander(rules []Rule) *Expander {
+	if rules == nil {
+		rules = DefaultRules
+	}
+	return &Expander{
+		rules:   rules,
+		memo:    make(map[memoKey]uint64),
+		memoBig: make(map[memoKeyBig]*big.Int),
+	}
+}
+
+// Stones returns how many stones the stone engraved with value turns into
+// after the given number of blinks.
+func (e *Expander) Stones(value uint64, blinks int) *big.Int {
+	if n, ok := e.count(value, blinks); ok {
+		return new(big.Int).SetUint64(n)
+	}
+	return new(big.Int).Set(e.countBig(strconv.FormatUint(value, 10), blinks))
+}
+
+// count returns false when a stone or the count outgrows a uint64.
+func (e *Expander) count(value uint64, blinks int) (uint64, bool) {
+	if blinks == 0 {
+		return 1, true
+	}
+	key := memoKey{value, blinks}
+	if n, ok := e.memo[key]; ok {
+		return n, true
+	}
+	next, ok := processStone(e.rules, value)
+	if !ok {
+		return 0, false
+	}
+	var total uint64
+	for _, v := range next {
+		n, ok := e.count(v, blinks-1)
+		if !ok {
+			return 0, false
+		}
+		if total, ok = helper.AddChecked(total, n); !ok {
+			return 0, false
+		}
+	}
+	e.memo[key] = total
+	return total, true
+}
+
+func (e *Expander) countBig(digits string, blinks int) *big.Int {
+	if blinks == 0 {
+		return big.NewInt(1)
+	}
+	key := memoKeyBig{digits, blinks}
+	if n, ok := e.memoBig[key]; ok {
+		return n
+	}
+	total := new(big.Int)
+	for _, d := range processBig(e.rules, digits) {
+		total.Add(total, e.countBig(d, blinks-1))
+	}
+	e.memoBig[key] = total
+	return total
+}
+
+// CountMemo is Count answered stone by stone from an Expander instead of
+// by simulating the whole population. It reports no stats.
+func CountMemo(stones []uint64, checkpoints []int, rules []Rule) []*big.Int {
+	e := NewExpander(rules)
+	counts := make([]*big.Int, len(checkpoints))
+	for i, blinks := range checkpoints {
+		counts[i] = new(big.Int)
+		for _, stone := range stones {
+			counts[i].Add(counts[i], e.Stones(stone, blinks))
+		}
+	}
+	return counts
+}
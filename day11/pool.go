@@ -0,0 +1,133 @@
+package day11
+
+import (
+	"sync"
+
+	"github.com/aoc2024/helper"
+)
+
+// shards is a set of stones, value to count, split so that every value
+// lives in the shard shardOf picks for it.
+type shards []map[uint64]uint64
+
+func shardOf(value uint64, n int) int {
+	// Fibonacci hashing spreads the small, clustered stone values evenly.
+	return int((value * 0x9E3779B97F4A7C15 >> 32) % uint64(n))
+}
+
+// pool blinks stones on a fixed set of goroutines, one per shard, kept
+// alive from one blink to the next. A blink runs in two phases separated by
+// a barrier: each worker first turns the stones of its own shard into local
+// maps, one per destination shard, and then merges what every worker
+// produced for its shard. Every map is only ever touched by one worker in a
+// phase, so no locks are needed.
+type pool struct {
+	rules []Rule
+	tasks []chan func(worker int)
+	done  sync.WaitGroup
+	// local[w][s] holds what worker w produced for shard s.
+	local [][]map[uint64]uint64
+	// overflow[w] is set when worker w saw a stone or count outgrow a
+	// uint64.
+	overflow []bool
+}
+
+func newPool(workers int, rules []Rule) *pool {
+	p := &pool{
+		rules:    rules,
+		tasks:    make([]chan func(int), workers),
+		local:    make([][]map[uint64]uint64, workers),
+		overflow: make([]bool, workers),
+	}
+	for w := range workers {
+		p.local[w] = make([]map[uint64]uint64, workers)
+		for s := range workers {
+			p.local[w][s] = make(map[uint64]uint64)
+		}
+		p.tasks[w] = make(chan func(int))
+		go func() {
+			for task := range p.tasks[w] {
+				task(w)
+				p.done.Done()
+			}
+		}()
+	}
+	return p
+}
+
+func (p *pool) workers() int {
+	return len(p.tasks)
+}
+
+// run runs phase on every worker and waits for all of them.
+func (p *pool) run(phase func(worker int)) {
+	p.done.Add(p.workers())
+	for _, tasks := range p.tasks {
+		tasks <- phase
+	}
+	p.done.Wait()
+}
+
+func (p *pool) close() {
+	for _, tasks := range p.tasks {
+		close(tasks)
+	}
+}
+
+// split puts stones into as many shards as the pool has workers.
+func (p *pool) split(stones map[uint64]uint64) shards {
+	out := make(shards, p.workers())
+	for s := range out {
+		out[s] = make(map[uint64]uint64)
+	}
+	for value, count := range stones {
+		out[shardOf(value, len(out))][value] += count
+	}
+	return out
+}
+
+// blink returns the stones after one blink, and false when a stone or a
+// count outgrows a uint64. stones is left as it was either way.
+func (p *pool) blink(stones shards) (shards, bool) {
+	n := p.workers()
+	p.run(func(w int) {
+		p.overflow[w] = false
+		local := p.local[w]
+		for value, count := range stones[w] {
+			next, ok := processStone(p.rules, value)
+			if !ok {
+				p.overflow[w] = true
+				return
+			}
+			for _, v := range next {
+				s := shardOf(v, n)
+				if local[s][v], ok = helper.AddChecked(local[s][v], count); !ok {
+					p.overflow[w] = true
+					return
+				}
+			}
+		}
+	})
+
+	out := make(shards, n)
+	p.run(func(s int) {
+		merged := make(map[uint64]uint64, len(p.local[s][s]))
+		for w := range n {
+			for value, count := range p.local[w][s] {
+				var ok bool
+				if merged[value], ok = helper.AddChecked(merged[value], count); !ok {
+					p.overflow[s] = true
+				}
+			}
+			clear(p.local[w][s])
+		}
+		out[s] = merged
+	})
+
+	for _, overflow := range p.overflow {
+		if overflow {
+			return nil, false
+		}
+	}
+	return out, true
+}
@@ -0,0 +1,102 @@
+package day11
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/aoc2024/helper"
+)
+
+// Rule is one line of the table deciding what a stone turns into when the
+// Historians blink. The first rule that matches a stone applies.
+type Rule struct {
+	Name    string
+	Matches func(stone uint64) bool
+	// Apply returns the stones replacing stone, and false when one of them
+	// does not fit a uint64.
+	Apply func(stone uint64) ([]uint64, bool)
+	// MatchesBig and ApplyBig are the same rule for stones written out in
+	// decimal, which is how stones too large for a uint64 are kept.
+	MatchesBig func(digits string) bool
+	ApplyBig   func(digits string) []string
+}
+
+// DefaultRules are the rules from the puzzle.
+var DefaultRules = []Rule{
+	{
+		Name:       "zero becomes one",
+		Matches:    func(stone uint64) bool { return stone == 0 },
+		Apply:      func(uint64) ([]uint64, bool) { return []uint64{1}, true },
+		MatchesBig: func(digits string) bool { return digits == "0" },
+		ApplyBig:   func(string) []string { return []string{"1"} },
+	},
+	{
+		Name:    "even digits split",
+		Matches: func(stone uint64) bool { return digits(stone)%2 == 0 },
+		Apply: func(stone uint64) ([]uint64, bool) {
+			half := pow10[digits(stone)/2]
+			return []uint64{stone / half, stone % half}, true
+		},
+		MatchesBig: func(digits string) bool { return len(digits)%2 == 0 },
+		ApplyBig: func(digits string) []string {
+			mid := len(digits) / 2
+			right := strings.TrimLeft(digits[mid:], "0")
+			if right == "" {
+				right = "0"
+			}
+			return []string{digits[:mid], right}
+		},
+	},
+	{
+		Name:    "others times 2024",
+		Matches: func(uint64) bool { return true },
+		Apply: func(stone uint64) ([]uint64, bool) {
+			multiplied, ok := helper.MulChecked(stone, 2024)
+			return []uint64{multiplied}, ok
+		},
+		MatchesBig: func(string) bool { return true },
+		ApplyBig: func(digits string) []string {
+			v, _ := new(big.Int).SetString(digits, 10)
+			return []string{v.Mul(v, big.NewInt(2024)).String()}
+		},
+	},
+}
+
+// pow10[i] is 10 to the power i, for every power a uint64 holds.
+var pow10 = func() [20]uint64 {
+	var p [20]uint64
+	p[0] = 1
+	for i := 1; i < len(p); i++ {
+		p[i] = p[i-1] * 10
+	}
+	return p
+}()
+
+// digits returns how many decimal digits v has, counting 0 as one digit.
+func digits(v uint64) int {
+	n := 1
+	for n < len(pow10) && v >= pow10[n] {
+		n++
+	}
+	return n
+}
+
+// processStone returns what stone turns into under rules, and false when
+// that is too large for a uint64. A stone no rule matches stays as it is.
+func processStone(rules []Rule, stone uint64) ([]uint64, bool) {
+	for _, r := range rules {
+		if r.Matches(stone) {
+			return r.Apply(stone)
+		}
+	}
+	return []uint64{stone}, true
+}
+
+func processBig(rules []Rule, digits string) []string {
+	for _, r := range rules {
+		if r.MatchesBig(digits) {
+			return r.ApplyBig(digits)
+		}
+	}
+	return []string{digits}
+}
@@ -0,0 +1,204 @@
+package day11
+
+import (
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(11, solver.Func(solve))
+	runner.RegisterExamples(11, fixture.MustParse(examples))
+	runner.RegisterFlags(11, func(fs *flag.FlagSet, prefix string) any {
+		o := defaultOptions()
+		fs.Var(&o.checkpoints, prefix+"blinks", "comma-separated blink `counts` to report; the first two are parts 1 and 2")
+		fs.IntVar(&o.workers, prefix+"workers", 0, "goroutines blinking the stones (0 for GOMAXPROCS)")
+		fs.BoolVar(&o.memo, prefix+"memo", false, "count stone by stone from a memo instead of blinking the whole population")
+		fs.BoolVar(&o.stats, prefix+"stats", false, "print the stone counts after every blink before the answers (ignores -"+prefix+"memo)")
+		return &o
+	})
+}
+
+type options struct {
+	checkpoints blinkList
+	workers     int
+	memo        bool
+	stats       bool
+}
+
+func defaultOptions() options {
+	return options{checkpoints: blinkList{25, 75}}
+}
+
+// blinkList is a flag.Value for a comma-separated list of blink counts.
+type blinkList []int
+
+func (l *blinkList) String() string {
+	parts := make([]string, len(*l))
+	for i, n := range *l {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (l *blinkList) Set(s string) error {
+	var list blinkList
+	for _, part := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid blink count %q", part)
+		}
+		list = append(list, n)
+	}
+	*l = list
+	return nil
+}
+
+// Stats describes the stones after a blink.
+type Stats struct {
+	Blink  int
+	Stones *big.Int
+	// Unique is how many different numbers are engraved on the stones.
+	Unique int
+}
+
+type Options struct {
+	// Rules defaults to DefaultRules.
+	Rules []Rule
+	// Workers is how many goroutines blink the stones; 0 means GOMAXPROCS.
+	Workers int
+	// OnBlink, when set, is called with the stats of every blink, starting
+	// with blink 0 for the stones as they were given.
+	OnBlink func(Stats)
+}
+
+// Count blinks at stones as many times as the largest of checkpoints and
+// returns how many stones there are after each checkpoint's number of
+// blinks.
+func Count(stones []uint64, checkpoints []int, opts Options) []*big.Int {
+	rules := opts.Rules
+	if rules == nil {
+		rules = DefaultRules
+	}
+	n := opts.Workers
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	p := newPool(n, rules)
+	defer p.close()
+
+	initial := make(map[uint64]uint64)
+	for _, stone := range stones {
+		initial[stone]++
+	}
+	native := p.split(initial)
+	// fallback takes over once native stones or counts overflow.
+	var fallback bigStones
+
+	counts := make([]*big.Int, len(checkpoints))
+	record := func(blink int) {
+		var stats Stats
+		if fallback != nil {
+			stats = Stats{Blink: blink, Stones: fallback.total(), Unique: len(fallback)}
+		} else {
+			var total helper.Total[uint64]
+			for _, shard := range native {
+				for _, count := range shard {
+					total.Add(count)
+				}
+				stats.Unique += len(shard)
+			}
+			stats.Blink, stats.Stones = blink, total.Big()
+		}
+		for i, c := range checkpoints {
+			if c == blink {
+				counts[i] = stats.Stones
+			}
+		}
+		if opts.OnBlink != nil {
+			opts.OnBlink(stats)
+		}
+	}
+
+	last := 0
+	if len(checkpoints) > 0 {
+		last = slices.Max(checkpoints)
+	}
+	record(0)
+	for blink := 1; blink <= last; blink++ {
+		if fallback == nil {
+			next, ok := p.blink(native)
+			if ok {
+				native = next
+			} else {
+				fallback = toBig(native)
+			}
+		}
+		if fallback != nil {
+			fallback = blinkBig(rules, fallback)
+		}
+		record(blink)
+	}
+	return counts
+}
+
+func result(count *big.Int) solver.Result {
+	if count.IsUint64() {
+		return solver.Uint64(count.Uint64())
+	}
+	return solver.Big(count)
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	if len(input) == 0 {
+		return solver.Result{}, solver.Result{}, helper.ErrEmptyInput
+	}
+	opts := solver.OptionsOr(in, defaultOptions())
+	checkpoints := opts.checkpoints
+	if len(checkpoints) == 0 {
+		return solver.Result{}, solver.Result{}, errors.New("no blink counts to report")
+	}
+	p := helper.NewParser(in.Mode)
+	stones := p.Uint64s(1, 1, input[0], "")
+	if err := p.Err(); err != nil {
+		return solver.Result{}, solver.Result{}, err
+	}
+
+	log := in.Logger()
+	var counts []*big.Int
+	if !opts.memo || opts.stats {
+		countOpts := Options{Workers: opts.workers}
+		if opts.stats {
+			countOpts.OnBlink = func(s Stats) {
+				fmt.Fprintf(log, "After %d blinks: %s stones (unique: %d)\n", s.Blink, s.Stones, s.Unique)
+			}
+		}
+		counts = Count(stones, checkpoints, countOpts)
+	} else {
+		counts = CountMemo(stones, checkpoints, nil)
+	}
+
+	part1, part2 := result(counts[0]), solver.NotImplemented()
+	if len(counts) > 1 {
+		part2 = result(counts[1])
+	}
+	for i, count := range counts[min(2, len(counts)):] {
+		fmt.Fprintf(log, "After %d blinks: %s stones\n", checkpoints[i+2], count)
+	}
+	return part1, part2, nil
+}
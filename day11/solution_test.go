@@ -0,0 +1,211 @@
+package day11
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand/v2"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aoc2024/helper/randtest"
+	"github.com/aoc2024/solver"
+)
+
+// countSerial is the plain single-threaded algorithm: one map, no shards,
+// no pool. It is the reference the pool is checked and benchmarked against.
+func countSerial(stones []uint64, blinks int) uint64 {
+	current := make(map[uint64]uint64)
+	for _, stone := range stones {
+		current[stone]++
+	}
+	for range blinks {
+		next := make(map[uint64]uint64, len(current))
+		for value, count := range current {
+			out, _ := processStone(DefaultRules, value)
+			for _, v := range out {
+				next[v] += count
+			}
+		}
+		current = next
+	}
+	var total uint64
+	for _, count := range current {
+		total += count
+	}
+	return total
+}
+
+func randomStones(n int) []uint64 {
+	return randtest.Cases(11, n, func(r *rand.Rand) uint64 { return r.Uint64N(10000000) })
+}
+
+func TestSmallCounts(t *testing.T) {
+	tests := []struct {
+		stones []uint64
+		want   []int64 // after 0, 1, 2, ... blinks
+	}{
+		{nil, []int64{0, 0}},
+		{[]uint64{0}, []int64{1, 1, 1, 2, 4}}, // 0, 1, 2024, 20 24, 2 0 2 4
+		{[]uint64{10}, []int64{1, 2, 2, 3}},   // 10, 1 0, 2024 1, 20 24 2024
+		{[]uint64{1000}, []int64{1, 2, 3, 3}}, // 1000, 10 0, 1 0 1, 2024 1 2024
+		{[]uint64{0, 0}, []int64{2, 2, 2, 4}},
+	}
+	for _, tt := range tests {
+		checkpoints := make([]int, len(tt.want))
+		for i := range checkpoints {
+			checkpoints[i] = i
+		}
+		for name, counts := range map[string][]*big.Int{
+			"Count":     Count(tt.stones, checkpoints, Options{}),
+			"CountMemo": CountMemo(tt.stones, checkpoints, nil),
+		} {
+			for i, want := range tt.want {
+				if counts[i].Cmp(big.NewInt(want)) != 0 {
+					t.Errorf("%s(%v) after %d blinks: %s stones, want %d", name, tt.stones, i, counts[i], want)
+				}
+			}
+		}
+	}
+}
+
+func TestCountMatchesSerial(t *testing.T) {
+	stones := randomStones(8)
+	want := []uint64{countSerial(stones, 0), countSerial(stones, 25), countSerial(stones, 40)}
+	for _, workers := range []int{1, 3, 8} {
+		got := Count(stones, []int{0, 25, 40}, Options{Workers: workers})
+		for i := range want {
+			if !got[i].IsUint64() || got[i].Uint64() != want[i] {
+				t.Errorf("%d workers: count %d = %s, want %d", workers, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestStatsStream(t *testing.T) {
+	var seen []Stats
+	counts := Count([]uint64{125, 17}, []int{6, 2}, Options{OnBlink: func(s Stats) { seen = append(seen, s) }})
+	if counts[0].Int64() != 22 || counts[1].Int64() != 4 {
+		t.Errorf("counts = %v, want [22 4]", counts)
+	}
+	if len(seen) != 7 || seen[0].Blink != 0 || seen[0].Stones.Int64() != 2 || seen[6].Unique == 0 {
+		t.Errorf("stats = %v", seen)
+	}
+}
+
+func TestRuleTable(t *testing.T) {
+	// Every stone just doubles.
+	doubling := []Rule{{
+		Name:       "double",
+		Matches:    func(uint64) bool { return true },
+		Apply:      func(stone uint64) ([]uint64, bool) { return []uint64{stone, stone}, true },
+		MatchesBig: func(string) bool { return true },
+		ApplyBig:   func(digits string) []string { return []string{digits, digits} },
+	}}
+	counts := Count([]uint64{1}, []int{10, 70}, Options{Rules: doubling})
+	if counts[0].Int64() != 1024 {
+		t.Errorf("after 10 doublings: %s stones, want 1024", counts[0])
+	}
+	if want := new(big.Int).Lsh(big.NewInt(1), 70); counts[1].Cmp(want) != 0 {
+		t.Errorf("after 70 doublings: %s stones, want %s", counts[1], want)
+	}
+}
+
+func TestOverflowFallsBackToBig(t *testing.T) {
+	// 19 digits: the stone is multiplied by 2024, past what a uint64 holds.
+	const huge = 9999999999999999999
+	if _, ok := processStone(DefaultRules, huge); ok {
+		t.Fatal("processStone did not report the overflow")
+	}
+	// 20239999999999999997976 and 40965759999999999995903424 have an odd
+	// number of digits; the third blink splits the latter in two. The
+	// later counts were worked out with arbitrary-precision integers.
+	checkpoints := []int{1, 2, 3, 4, 10, 30}
+	want := []int64{1, 1, 2, 2, 31, 152837}
+	counts := Count([]uint64{huge}, checkpoints, Options{})
+	for i, c := range checkpoints {
+		if counts[i].Cmp(big.NewInt(want[i])) != 0 {
+			t.Errorf("after %d blinks: %s stones, want %d", c, counts[i], want[i])
+		}
+	}
+}
+
+func TestBlinkBigMatchesNative(t *testing.T) {
+	stones := bigStones{"125": big.NewInt(1), "17": big.NewInt(1)}
+	for range 25 {
+		stones = blinkBig(DefaultRules, stones)
+	}
+	if got := stones.total(); got.Cmp(big.NewInt(55312)) != 0 {
+		t.Errorf("blinkBig = %s, want 55312", got)
+	}
+}
+
+func TestSolveLog(t *testing.T) {
+	var log strings.Builder
+	opts := defaultOptions()
+	opts.checkpoints = blinkList{1, 2, 6}
+	_, _, err := solve(solver.Input{Lines: []string{"125 17"}, Options: &opts, Log: &log})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := log.String(), "After 6 blinks: 22 stones\n"; got != want {
+		t.Errorf("log = %q, want %q", got, want)
+	}
+}
+
+func BenchmarkBlink(b *testing.B) {
+	stones := randomStones(50)
+	b.Run("serial", func(b *testing.B) {
+		for range b.N {
+			countSerial(stones, 75)
+		}
+	})
+	counts := []int{1, 2, 4, runtime.GOMAXPROCS(0)}
+	slices.Sort(counts)
+	for _, workers := range slices.Compact(counts) {
+		b.Run(fmt.Sprintf("pool-%d", workers), func(b *testing.B) {
+			for range b.N {
+				Count(stones, []int{75}, Options{Workers: workers})
+			}
+		})
+	}
+}
+
+func TestDigits(t *testing.T) {
+	for _, tc := range []struct {
+		v    uint64
+		want int
+	}{{0, 1}, {9, 1}, {10, 2}, {99, 2}, {1000, 4}, {18446744073709551615, 20}} {
+		if got := digits(tc.v); got != tc.want {
+			t.Errorf("digits(%d) = %d, want %d", tc.v, got, tc.want)
+		}
+	}
+}
+
+func TestExpander(t *testing.T) {
+	e := NewExpander(nil)
+	if got := e.Stones(125, 6).Int64() + e.Stones(17, 6).Int64(); got != 22 {
+		t.Errorf("125 17 after 6 blinks = %d stones, want 22", got)
+	}
+	stones := randomStones(8)
+	memo := CountMemo(stones, []int{25, 40}, nil)
+	simulated := Count(stones, []int{25, 40}, Options{})
+	for i := range memo {
+		if memo[i].Cmp(simulated[i]) != 0 {
+			t.Errorf("checkpoint %d: memo counts %s, simulation %s", i, memo[i], simulated[i])
+		}
+	}
+
+	const huge = 9999999999999999999
+	if got, want := e.Stones(huge, 30), Count([]uint64{huge}, []int{30}, Options{})[0]; got.Cmp(want) != 0 {
+		t.Errorf("overflowing stone: memo counts %s, simulation %s", got, want)
+	}
+}
+
+func BenchmarkCountMemo(b *testing.B) {
+	stones := randomStones(50)
+	for range b.N {
+		CountMemo(stones, []int{75}, nil)
+	}
+}
@@ -0,0 +1,109 @@
+package day12
+
+import (
+	_ "embed"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper/graph"
+	"github.com/aoc2024/helper/grid"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(12, solver.Func(solve))
+	runner.RegisterExamples(12, fixture.MustParse(examples))
+}
+
+type Region struct {
+	char   rune
+	points map[grid.Point]bool
+}
+
+func (r *Region) size() int {
+	return len(r.points)
+}
+
+func doRegion(start grid.Point, garden *grid.Grid[rune]) *Region {
+	c := garden.At(start)
+	sameCrop := graph.Filter(garden.Neighbors4, func(_, to grid.Point) bool {
+		return garden.At(to) == c
+	})
+	return &Region{char: c, points: graph.FloodFill(start, sameCrop)}
+}
+
+func getRegions(garden *grid.Grid[rune]) []*Region {
+	regions := []*Region{}
+	seen := grid.New[bool](garden.Width, garden.Height)
+	for p := range garden.All() {
+		if !seen.At(p) {
+			region := doRegion(p, garden)
+			for q := range region.points {
+				seen.Set(q, true)
+			}
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+func perimeter(region *Region) int {
+	n := 0
+	for p := range region.points {
+		for _, dir := range grid.Dirs4 {
+			if !region.points[p.Add(dir)] {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func price(region *Region) int {
+	return perimeter(region) * region.size()
+}
+
+func perimeter2(region *Region) int {
+	n := 0
+	checks := []struct {
+		next, p1, p2 grid.Point
+	}{
+		{grid.Point{X: 1, Y: 0}, grid.Point{X: 0, Y: -1}, grid.Point{X: 1, Y: -1}},   // right
+		{grid.Point{X: -1, Y: 0}, grid.Point{X: 0, Y: -1}, grid.Point{X: -1, Y: -1}}, // left
+		{grid.Point{X: 0, Y: 1}, grid.Point{X: -1, Y: 0}, grid.Point{X: -1, Y: 1}},   // down
+		{grid.Point{X: 0, Y: -1}, grid.Point{X: -1, Y: 0}, grid.Point{X: -1, Y: -1}}, // up
+	}
+
+	for p := range region.points {
+		for _, check := range checks {
+			next := p.Add(check.next)
+			p1 := p.Add(check.p1)
+			p2 := p.Add(check.p2)
+
+			if !region.points[next] && !(region.points[p1] && !region.points[p2]) {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func price2(region *Region) int {
+	return perimeter2(region) * region.size()
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	regions := getRegions(grid.FromLines(input))
+	part1, part2 := 0, 0
+
+	for _, region := range regions {
+		part1 += price(region)
+		part2 += price2(region)
+	}
+
+	return solver.Int(part1), solver.Int(part2), nil
+}
@@ -0,0 +1,189 @@
+package day13
+
+import (
+	"errors"
+	"math"
+
+	"github.com/aoc2024/helper/linalg"
+	"github.com/aoc2024/helper/numtheory"
+)
+
+// Vec is a claw movement or position. The prizes of part 2 are too far away
+// for an int on 32-bit platforms.
+type Vec struct {
+	X, Y int64
+}
+
+// Machine is one claw machine: how far each button moves the claw, and
+// where the prize is.
+type Machine struct {
+	A, B, Prize Vec
+}
+
+// Config is what playing a machine costs and allows.
+type Config struct {
+	// CostA and CostB are the tokens one press of each button costs. They
+	// must be positive.
+	CostA, CostB int64
+	// MaxA and MaxB cap how often each button may be pressed; 0 means no
+	// cap.
+	MaxA, MaxB int64
+	// Offset is added to both coordinates of every prize.
+	Offset int64
+}
+
+// Outcome says whether a machine's prize can be won.
+type Outcome int
+
+const (
+	// Unreachable means no number of presses puts the claw on the prize.
+	Unreachable Outcome = iota
+	// Reachable means the prize can be won within the press caps.
+	Reachable
+	// OverBudget means the prize can be reached, but only by pressing a
+	// button more often than its cap allows.
+	OverBudget
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Unreachable:
+		return "unreachable"
+	case Reachable:
+		return "reachable"
+	case OverBudget:
+		return "over budget"
+	}
+	return "unknown"
+}
+
+// Report is the result of playing one machine. For a reachable machine the
+// presses and cost are the cheapest way to win; for one over budget they are
+// the cheapest way to reach the prize ignoring the caps.
+type Report struct {
+	Machine        Machine
+	Outcome        Outcome
+	PressA, PressB int64
+	Cost           int64
+}
+
+// Play finds the cheapest way to win m's prize under cfg.
+func Play(m Machine, cfg Config) Report {
+	m.Prize.X += cfg.Offset
+	m.Prize.Y += cfg.Offset
+	r := Report{Machine: m}
+
+	uncapped := cfg
+	uncapped.MaxA, uncapped.MaxB = 0, 0
+	a, b, ok := cheapest(m, uncapped)
+	if !ok {
+		return r
+	}
+	r.Outcome = Reachable
+	if cfg.MaxA != 0 || cfg.MaxB != 0 {
+		if capA, capB, ok := cheapest(m, cfg); ok {
+			a, b = capA, capB
+		} else {
+			r.Outcome = OverBudget
+		}
+	}
+	r.PressA, r.PressB = a, b
+	r.Cost = cfg.CostA*a + cfg.CostB*b
+	return r
+}
+
+// cheapest returns the cheapest number of presses of buttons A and B that
+// reaches the prize within the caps of cfg. ok is false when there is none.
+func cheapest(m Machine, cfg Config) (pressA, pressB int64, ok bool) {
+	presses, err := linalg.SolveInt64([][]int64{{m.A.X, m.B.X}, {m.A.Y, m.B.Y}}, []int64{m.Prize.X, m.Prize.Y})
+	switch {
+	case errors.Is(err, linalg.ErrUnderdetermined):
+		// Both buttons push along the line to the prize, so any
+		// combination that gets there on one axis gets there on both.
+		if m.A.X != 0 || m.B.X != 0 {
+			return cheapestOnLine(m.A.X, m.B.X, m.Prize.X, cfg)
+		}
+		return cheapestOnLine(m.A.Y, m.B.Y, m.Prize.Y, cfg)
+	case err != nil:
+		return 0, 0, false
+	}
+
+	counts, ok := linalg.Ints(presses)
+	if !ok || !withinCap(counts[0], cfg.MaxA) || !withinCap(counts[1], cfg.MaxB) {
+		return 0, 0, false
+	}
+	return counts[0], counts[1], true
+}
+
+func withinCap(presses, limit int64) bool {
+	return presses >= 0 && (limit == 0 || presses <= limit)
+}
+
+// cheapestOnLine solves p*A + q*B = r for presses A and B within the caps
+// of cfg, at the lowest cost.
+func cheapestOnLine(p, q, r int64, cfg Config) (int64, int64, bool) {
+	switch {
+	case p == 0 && q == 0:
+		return 0, 0, r == 0
+	case p == 0:
+		return 0, r / q, r%q == 0 && withinCap(r/q, cfg.MaxB)
+	case q == 0:
+		return r / p, 0, r%p == 0 && withinCap(r/p, cfg.MaxA)
+	}
+
+	g, x, y := numtheory.ExtGCD(p, q)
+	if r%g != 0 {
+		return 0, 0, false
+	}
+	// Every solution is A = a0 + stepA*t, B = b0 + stepB*t for an integer t.
+	a0, b0 := x*(r/g), y*(r/g)
+	stepA, stepB := q/g, -p/g
+
+	lo, hi := int64(math.MinInt64), int64(math.MaxInt64)
+	for _, c := range []struct{ base, step, max int64 }{{a0, stepA, cfg.MaxA}, {b0, stepB, cfg.MaxB}} {
+		// 0 <= base + step*t, and <= max when there is a cap.
+		tLo, tHi := ceilDiv(-c.base, c.step), int64(math.MaxInt64)
+		if c.max != 0 {
+			tHi = floorDiv(c.max-c.base, c.step)
+		}
+		if c.step < 0 {
+			tLo, tHi = int64(math.MinInt64), floorDiv(-c.base, c.step)
+			if c.max != 0 {
+				tLo = ceilDiv(c.max-c.base, c.step)
+			}
+		}
+		lo, hi = max(lo, tLo), min(hi, tHi)
+	}
+	if lo > hi {
+		return 0, 0, false
+	}
+
+	// The cost is linear in t, so the cheapest solution is at an end of
+	// the range. Costs are positive, so the range is bounded on the cheap
+	// side.
+	t := lo
+	if cfg.CostA*stepA+cfg.CostB*stepB < 0 {
+		t = hi
+	}
+	if t == math.MinInt64 || t == math.MaxInt64 {
+		return 0, 0, false
+	}
+	return a0 + stepA*t, b0 + stepB*t, true
+}
+
+// floorDiv and ceilDiv round a/b down and up, whatever the signs.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func ceilDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) == (b < 0) {
+		q++
+	}
+	return q
+}
@@ -0,0 +1,148 @@
+package day13
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aoc2024/solver"
+)
+
+func TestPlay(t *testing.T) {
+	part1 := Config{CostA: 3, CostB: 1, MaxA: 100, MaxB: 100}
+	tests := []struct {
+		name string
+		m    Machine
+		cfg  Config
+		want Report
+	}{
+		{
+			name: "first machine of the puzzle",
+			m:    Machine{A: Vec{94, 34}, B: Vec{22, 67}, Prize: Vec{8400, 5400}},
+			cfg:  part1,
+			want: Report{Outcome: Reachable, PressA: 80, PressB: 40, Cost: 280},
+		},
+		{
+			name: "no whole number of presses",
+			m:    Machine{A: Vec{26, 66}, B: Vec{67, 21}, Prize: Vec{12748, 12176}},
+			cfg:  part1,
+			want: Report{Outcome: Unreachable},
+		},
+		{
+			name: "needs more presses than the cap",
+			m:    Machine{A: Vec{1, 0}, B: Vec{0, 1}, Prize: Vec{150, 5}},
+			cfg:  part1,
+			want: Report{Outcome: OverBudget, PressA: 150, PressB: 5, Cost: 455},
+		},
+		{
+			name: "needs negative presses",
+			m:    Machine{A: Vec{1, 0}, B: Vec{0, 1}, Prize: Vec{-1, 5}},
+			cfg:  part1,
+			want: Report{Outcome: Unreachable},
+		},
+		{
+			name: "collinear buttons",
+			m:    Machine{A: Vec{2, 2}, B: Vec{4, 4}, Prize: Vec{10, 10}},
+			cfg:  part1,
+			want: Report{Outcome: Reachable, PressA: 1, PressB: 2, Cost: 5},
+		},
+		{
+			name: "collinear buttons off the prize's line",
+			m:    Machine{A: Vec{2, 2}, B: Vec{4, 4}, Prize: Vec{10, 12}},
+			cfg:  part1,
+			want: Report{Outcome: Unreachable},
+		},
+	}
+	for _, tt := range tests {
+		got := Play(tt.m, tt.cfg)
+		tt.want.Machine = tt.m
+		if got != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCheapestOnLine(t *testing.T) {
+	// 4A + 2B = 10 is solved by (0, 5), (1, 3) and (2, 1).
+	tests := []struct {
+		name    string
+		p, q, r int64
+		cfg     Config
+		a, b    int64
+		ok      bool
+	}{
+		{"A cheaper per step", 4, 2, 10, Config{CostA: 1, CostB: 3}, 2, 1, true},
+		{"B cheaper per step", 4, 2, 10, Config{CostA: 3, CostB: 1}, 0, 5, true},
+		{"B cheaper but capped", 4, 2, 10, Config{CostA: 3, CostB: 1, MaxB: 3}, 1, 3, true},
+		{"A cheaper but capped", 4, 2, 10, Config{CostA: 1, CostB: 3, MaxA: 1}, 1, 3, true},
+		{"every solution over a cap", 4, 2, 10, Config{CostA: 1, CostB: 1, MaxA: 1, MaxB: 2}, 0, 0, false},
+		{"gcd does not divide", 4, 6, 7, Config{CostA: 1, CostB: 1}, 0, 0, false},
+		{"equal cost per step", 1, 1, 3, Config{CostA: 2, CostB: 2}, 0, 3, true},
+		{"A does not move", 0, 3, 9, Config{CostA: 1, CostB: 1}, 0, 3, true},
+		{"A does not move, B overshoots", 0, 3, 10, Config{CostA: 1, CostB: 1}, 0, 0, false},
+		{"B does not move, over the cap", 5, 0, 10, Config{CostA: 1, CostB: 1, MaxA: 1}, 0, 0, false},
+		{"nothing moves, prize at the start", 0, 0, 0, Config{CostA: 1, CostB: 1}, 0, 0, true},
+		{"nothing moves", 0, 0, 5, Config{CostA: 1, CostB: 1}, 0, 0, false},
+		{"prize far away", 3, 5, 10000000000001, Config{CostA: 3, CostB: 1}, 2, 1999999999999, true},
+	}
+	for _, tt := range tests {
+		a, b, ok := cheapestOnLine(tt.p, tt.q, tt.r, tt.cfg)
+		if ok != tt.ok || (ok && (a != tt.a || b != tt.b)) {
+			t.Errorf("%s: cheapestOnLine(%d, %d, %d) = %d, %d, %v, want %d, %d, %v",
+				tt.name, tt.p, tt.q, tt.r, a, b, ok, tt.a, tt.b, tt.ok)
+		}
+	}
+}
+
+func TestFloorCeilDiv(t *testing.T) {
+	tests := []struct {
+		a, b, floor, ceil int64
+	}{
+		{7, 2, 3, 4},
+		{-7, 2, -4, -3},
+		{7, -2, -4, -3},
+		{-7, -2, 3, 4},
+		{6, -3, -2, -2},
+		{-6, 3, -2, -2},
+		{0, -5, 0, 0},
+		{-1, 1000, -1, 0},
+	}
+	for _, tt := range tests {
+		if got := floorDiv(tt.a, tt.b); got != tt.floor {
+			t.Errorf("floorDiv(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.floor)
+		}
+		if got := ceilDiv(tt.a, tt.b); got != tt.ceil {
+			t.Errorf("ceilDiv(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.ceil)
+		}
+	}
+}
+
+func TestSolveOptions(t *testing.T) {
+	lines := []string{
+		"Button A: X+1, Y+0",
+		"Button B: X+0, Y+1",
+		"Prize: X=150, Y=5",
+	}
+	opts := defaultOptions
+	opts.part1.MaxA = 0
+	opts.report = true
+	var log strings.Builder
+	part1, _, err := solve(solver.Input{Lines: lines, Options: &opts, Log: &log})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := part1.String(); got != "455" {
+		t.Errorf("uncapped part 1 = %s, want 455", got)
+	}
+	if want := "part 1, machine 1: reachable, A×150 B×5 for 455 tokens\n"; !strings.HasPrefix(log.String(), want) {
+		t.Errorf("report starts %q, want %q", log.String(), want)
+	}
+
+	// The defaults, with the cap of 100 presses, are untouched.
+	part1, _, err = solve(solver.Input{Lines: lines})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := part1.String(); got != "0" {
+		t.Errorf("default part 1 = %s, want 0", got)
+	}
+}
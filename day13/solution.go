@@ -0,0 +1,112 @@
+package day13
+
+import (
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(13, solver.Func(solve))
+	runner.RegisterExamples(13, fixture.MustParse(examples))
+	runner.RegisterFlags(13, func(fs *flag.FlagSet, prefix string) any {
+		o := defaultOptions
+		fs.Int64Var(&o.part1.CostA, prefix+"cost-a", o.part1.CostA, "tokens one press of button A costs")
+		fs.Int64Var(&o.part1.CostB, prefix+"cost-b", o.part1.CostB, "tokens one press of button B costs")
+		fs.Int64Var(&o.part1.MaxA, prefix+"max-a", o.part1.MaxA, "most presses of button A in part 1 (0 for no cap)")
+		fs.Int64Var(&o.part1.MaxB, prefix+"max-b", o.part1.MaxB, "most presses of button B in part 1 (0 for no cap)")
+		fs.Int64Var(&o.part2Offset, prefix+"offset", o.part2Offset, "how much further away the prizes are in part 2")
+		fs.BoolVar(&o.report, prefix+"report", false, "print what every machine contributes before the answers")
+		return &o
+	})
+}
+
+type options struct {
+	// part1 is how the machines are played in part 1. Part 2 plays them at
+	// the same costs, without caps and with the prizes moved by part2Offset.
+	part1       Config
+	part2Offset int64
+	report      bool
+}
+
+var defaultOptions = options{
+	part1:       Config{CostA: 3, CostB: 1, MaxA: 100, MaxB: 100},
+	part2Offset: 10000000000000,
+}
+
+func parseInput(p *helper.Parser, lines []string) []Machine {
+	var result []Machine
+	for i := 0; i < len(lines); i += 4 {
+		if i+2 >= len(lines) {
+			break
+		}
+
+		var m Machine
+
+		p.Scanf(i+1, lines[i], "Button A: X+%d, Y+%d", &m.A.X, &m.A.Y)
+		p.Scanf(i+2, lines[i+1], "Button B: X+%d, Y+%d", &m.B.X, &m.B.Y)
+		p.Scanf(i+3, lines[i+2], "Prize: X=%d, Y=%d", &m.Prize.X, &m.Prize.Y)
+
+		result = append(result, m)
+	}
+	return result
+}
+
+// playAll plays every machine and returns the reports along with the tokens
+// it takes to win every prize that can be won.
+func playAll(machines []Machine, cfg Config) ([]Report, int64) {
+	reports := make([]Report, len(machines))
+	var total int64
+	for i, m := range machines {
+		reports[i] = Play(m, cfg)
+		if reports[i].Outcome == Reachable {
+			total += reports[i].Cost
+		}
+	}
+	return reports, total
+}
+
+func printReports(w io.Writer, part int, reports []Report) {
+	for i, r := range reports {
+		fmt.Fprintf(w, "part %d, machine %d: %s", part, i+1, r.Outcome)
+		if r.Outcome != Unreachable {
+			fmt.Fprintf(w, ", A×%d B×%d for %d tokens", r.PressA, r.PressB, r.Cost)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	opts := solver.OptionsOr(in, defaultOptions)
+	part1 := opts.part1
+	if part1.CostA <= 0 || part1.CostB <= 0 {
+		return solver.Result{}, solver.Result{}, errors.New("button costs must be positive")
+	}
+
+	p := helper.NewParser(in.Mode)
+	machines := parseInput(p, input)
+	if err := p.Err(); err != nil {
+		return solver.Result{}, solver.Result{}, err
+	}
+
+	part2 := Config{CostA: part1.CostA, CostB: part1.CostB, Offset: opts.part2Offset}
+	reports1, total1 := playAll(machines, part1)
+	reports2, total2 := playAll(machines, part2)
+	if opts.report {
+		printReports(in.Logger(), 1, reports1)
+		printReports(in.Logger(), 2, reports2)
+	}
+
+	return solver.Int64(total1), solver.Int64(total2), nil
+}
@@ -0,0 +1,135 @@
+package day14
+
+import (
+	_ "embed"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/helper/geom"
+	"github.com/aoc2024/helper/numtheory"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(14, solver.Func(solve))
+	runner.RegisterExamples(14, fixture.MustParse(examples))
+}
+
+type Robot struct {
+	pos geom.Vec2
+	vel geom.Vec2
+}
+
+func parseRobot(p *helper.Parser, lineNum int, line string) Robot {
+	var robot Robot
+	p.Scanf(lineNum, line, "p=%d,%d v=%d,%d", &robot.pos.X, &robot.pos.Y, &robot.vel.X, &robot.vel.Y)
+	return robot
+}
+
+func simulate(robots []Robot, width, height int) []Robot {
+	result := make([]Robot, len(robots))
+	for i, robot := range robots {
+		result[i] = Robot{robot.pos.Add(robot.vel).Wrap(width, height), robot.vel}
+	}
+	return result
+}
+
+func robotsInQuads(robots []Robot, width, height int) [4]int {
+	midX := width / 2
+	midY := height / 2
+	quads := [4]int{}
+
+	for _, robot := range robots {
+		if robot.pos.X == midX || robot.pos.Y == midY {
+			continue
+		}
+
+		if robot.pos.X < midX {
+			if robot.pos.Y < midY {
+				quads[0]++
+			} else {
+				quads[2]++
+			}
+		} else {
+			if robot.pos.Y < midY {
+				quads[1]++
+			} else {
+				quads[3]++
+			}
+		}
+	}
+
+	return quads
+}
+
+// spread returns n² times the variance of the robots' coordinate, as picked
+// by coord, after t seconds on an axis of the given size. Comparing it across
+// times needs no division.
+func spread(robots []Robot, t, size int, coord func(geom.Vec2) int) int {
+	sum, sumSquares := 0, 0
+	for _, robot := range robots {
+		c := helper.Mod(coord(robot.pos)+coord(robot.vel)*t, size)
+		sum += c
+		sumSquares += c * c
+	}
+	return len(robots)*sumSquares - sum*sum
+}
+
+// tightestTime returns the time in [0, size) at which the robots are the
+// least spread out along one axis. Positions on that axis repeat every size
+// seconds, so no later time can do better.
+func tightestTime(robots []Robot, size int, coord func(geom.Vec2) int) int {
+	best, bestTime := -1, 0
+	for t := 0; t < size; t++ {
+		if s := spread(robots, t, size, coord); best < 0 || s < best {
+			best, bestTime = s, t
+		}
+	}
+	return bestTime
+}
+
+// findTree finds the first time the robots draw the Christmas tree. The
+// picture clumps the robots together on both axes at once; the x positions
+// repeat every width seconds and the y positions every height seconds, so
+// the best time of each axis alone gives a congruence and the Chinese
+// Remainder Theorem combines them.
+func findTree(robots []Robot, width, height int) (int, error) {
+	tx := tightestTime(robots, width, func(v geom.Vec2) int { return v.X })
+	ty := tightestTime(robots, height, func(v geom.Vec2) int { return v.Y })
+	t, _, err := numtheory.CRT([]int{tx, ty}, []int{width, height})
+	return t, err
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	width, height := 101, 103
+	robots := make([]Robot, 0)
+
+	p := helper.NewParser(in.Mode)
+	for i, line := range input {
+		robots = append(robots, parseRobot(p, i+1, line))
+	}
+	if err := p.Err(); err != nil {
+		return solver.Result{}, solver.Result{}, err
+	}
+
+	// Part 1
+	currentState := robots
+	for i := 0; i < 100; i++ {
+		currentState = simulate(currentState, width, height)
+	}
+	quads := robotsInQuads(currentState, width, height)
+	part1 := quads[0] * quads[1] * quads[2] * quads[3]
+
+	// Part 2
+	part2, err := findTree(robots, width, height)
+	if err != nil {
+		return solver.Result{}, solver.Result{}, err
+	}
+
+	return solver.Int(part1), solver.Int(part2), nil
+}
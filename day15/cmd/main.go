@@ -0,0 +1,10 @@
+package main
+
+import (
+	_ "github.com/aoc2024/day15"
+	"github.com/aoc2024/runner"
+)
+
+func main() {
+	runner.Main(15)
+}
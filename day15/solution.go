@@ -0,0 +1,236 @@
+package day15
+
+import (
+	_ "embed"
+	"errors"
+	"strings"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/helper/geom"
+	"github.com/aoc2024/helper/grid"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(15, solver.Func(solve))
+	runner.RegisterExamples(15, fixture.MustParse(examples))
+}
+
+// ErrNoRobot is returned for a warehouse map with no robot drawn in it.
+var ErrNoRobot = errors.New("the warehouse has no robot")
+
+// movement returns the step for an instruction arrow. Anything else, such as
+// stray characters in the instructions, doesn't move the robot.
+func movement(instruction rune) geom.Vec2 {
+	if dir, ok := geom.ParseArrow(instruction); ok {
+		return dir.Vec()
+	}
+	return geom.Vec2{}
+}
+
+// blocked reports whether the robot or a box can't move onto p: a wall, or
+// off the map when the map isn't walled in.
+func blocked(warehouse *grid.Grid[rune], p grid.Point) bool {
+	cell, ok := warehouse.Get(p)
+	return !ok || cell == '#'
+}
+
+// cellAt returns the cell at p, or 0 off the map.
+func cellAt(warehouse *grid.Grid[rune], p grid.Point) rune {
+	cell, _ := warehouse.Get(p)
+	return cell
+}
+
+func processWarehouseInput(input []string) (*grid.Grid[rune], grid.Point, string, bool) {
+	var instructions string
+
+	mapEnd := len(input)
+	for i, line := range input {
+		if line == "" {
+			mapEnd = i
+			break
+		}
+	}
+	for _, line := range input[mapEnd:] {
+		instructions += strings.TrimSpace(line)
+	}
+
+	warehouse := grid.FromLines(input[:mapEnd])
+	robotStart, found := grid.Find(warehouse, '@')
+	if found {
+		warehouse.Set(robotStart, '.')
+	}
+
+	return warehouse, robotStart, instructions, found
+}
+
+func moveRobot(warehouse *grid.Grid[rune], robotPos grid.Point, instruction rune) (*grid.Grid[rune], grid.Point) {
+	move := movement(instruction)
+	at := func(n int) grid.Point {
+		return grid.Point{X: robotPos.X + n*move.X, Y: robotPos.Y + n*move.Y}
+	}
+	pathLength := 1
+
+	for cellAt(warehouse, at(pathLength)) == 'O' {
+		pathLength++
+	}
+
+	if blocked(warehouse, at(pathLength)) {
+		return warehouse, robotPos
+	}
+
+	updatedWarehouse := warehouse.Clone()
+	if pathLength > 1 {
+		updatedWarehouse.Set(at(pathLength), 'O')
+		updatedWarehouse.Set(at(1), '.')
+	}
+	return updatedWarehouse, at(1)
+}
+
+func moveWideRobot(warehouse *grid.Grid[rune], robotPos grid.Point, instruction rune) (*grid.Grid[rune], grid.Point) {
+	move := movement(instruction)
+	right := geom.Right.Vec()
+	updatedWarehouse := warehouse.Clone()
+
+	if blocked(warehouse, robotPos.Add(move)) {
+		return warehouse, robotPos
+	}
+
+	containers := scanForContainers(warehouse, robotPos, move)
+	if len(containers) == 0 {
+		return updatedWarehouse, robotPos.Add(move)
+	}
+
+	for _, container := range containers {
+		if blocked(warehouse, container.Add(move)) {
+			return warehouse, robotPos
+		}
+		if blocked(warehouse, container.Add(right).Add(move)) {
+			return warehouse, robotPos
+		}
+	}
+
+	for _, container := range containers {
+		updatedWarehouse.Set(container, '.')
+		updatedWarehouse.Set(container.Add(right), '.')
+	}
+
+	for _, container := range containers {
+		updatedWarehouse.Set(container.Add(move), '[')
+		updatedWarehouse.Set(container.Add(right).Add(move), ']')
+	}
+
+	return updatedWarehouse, robotPos.Add(move)
+}
+
+func scanForContainers(warehouse *grid.Grid[rune], pos grid.Point, move grid.Point) []grid.Point {
+	containers := make([]grid.Point, 0)
+
+	var scanContainer func(grid.Point)
+	scanContainer = func(box grid.Point) {
+		left := grid.Point{X: box.X - 1, Y: box.Y}
+		right := grid.Point{X: box.X + 1, Y: box.Y}
+		switch cellAt(warehouse, box) {
+		case '[':
+			containers = append(containers, box)
+			scanContainer(box.Add(move))
+			if move.X != -1 {
+				scanContainer(right.Add(move))
+			}
+		case ']':
+			containers = append(containers, left)
+			if move.X != 1 {
+				scanContainer(left.Add(move))
+			}
+			scanContainer(box.Add(move))
+		}
+	}
+
+	scanContainer(pos.Add(move))
+	return containers
+}
+
+func expandWarehouse(warehouse *grid.Grid[rune]) *grid.Grid[rune] {
+	wideWarehouse := grid.New[rune](warehouse.Width*2, warehouse.Height)
+	for p, cell := range warehouse.All() {
+		left := grid.Point{X: p.X * 2, Y: p.Y}
+		right := grid.Point{X: p.X*2 + 1, Y: p.Y}
+		switch cell {
+		case '#':
+			wideWarehouse.Set(left, '#')
+			wideWarehouse.Set(right, '#')
+		case 'O':
+			wideWarehouse.Set(left, '[')
+			wideWarehouse.Set(right, ']')
+		case '.':
+			wideWarehouse.Set(left, '.')
+			wideWarehouse.Set(right, '.')
+		case '@':
+			wideWarehouse.Set(left, '@')
+			wideWarehouse.Set(right, '.')
+		}
+	}
+	return wideWarehouse
+}
+
+func executeInstructions(warehouse *grid.Grid[rune], start grid.Point, instructions string) (*grid.Grid[rune], grid.Point) {
+	currentWarehouse := warehouse.Clone()
+	currentPos := start
+
+	for _, instruction := range instructions {
+		currentWarehouse, currentPos = moveRobot(currentWarehouse, currentPos, instruction)
+	}
+
+	return currentWarehouse, currentPos
+}
+
+func executeWideInstructions(warehouse *grid.Grid[rune], start grid.Point, instructions string) (*grid.Grid[rune], grid.Point) {
+	wideWarehouse := expandWarehouse(warehouse)
+	currentPos := grid.Point{X: start.X * 2, Y: start.Y}
+
+	for _, instruction := range instructions {
+		wideWarehouse, currentPos = moveWideRobot(wideWarehouse, currentPos, instruction)
+	}
+
+	return wideWarehouse, currentPos
+}
+
+func calculateWarehouseScore(warehouse *grid.Grid[rune]) int {
+	score := 0
+	for _, p := range grid.FindAll(warehouse, 'O') {
+		score += p.Y*100 + p.X
+	}
+	return score
+}
+
+func calculateWideWarehouseScore(warehouse *grid.Grid[rune]) int {
+	score := 0
+	for _, p := range grid.FindAll(warehouse, '[') {
+		score += p.Y*100 + p.X
+	}
+	return score
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	if len(input) == 0 {
+		return solver.Result{}, solver.Result{}, helper.ErrEmptyInput
+	}
+	warehouse, robotStart, instructions, found := processWarehouseInput(input)
+	if !found {
+		return solver.Result{}, solver.Result{}, ErrNoRobot
+	}
+
+	finalWarehouse, _ := executeInstructions(warehouse, robotStart, instructions)
+	normalScore := calculateWarehouseScore(finalWarehouse)
+
+	wideWarehouse, _ := executeWideInstructions(warehouse, robotStart, instructions)
+	wideScore := calculateWideWarehouseScore(wideWarehouse)
+
+	return solver.Int(normalScore), solver.Int(wideScore), nil
+}
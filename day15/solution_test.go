@@ -0,0 +1,25 @@
+package day15
+
+import (
+	"testing"
+
+	"github.com/aoc2024/solver"
+)
+
+func TestUnwalledWarehouse(t *testing.T) {
+	// The map's edge stops the box as a wall would: in the narrow warehouse
+	// after one push, in the wide one after two.
+	part1, part2, err := solve(solver.Input{Lines: []string{"@O.", "", ">>>"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if part1.String() != "2" || part2.String() != "4" {
+		t.Errorf("got %s and %s, want 2 and 4", part1, part2)
+	}
+}
+
+func TestNoRobot(t *testing.T) {
+	if _, _, err := solve(solver.Input{Lines: []string{"#O.", "", "<"}}); err != ErrNoRobot {
+		t.Errorf("got %v, want ErrNoRobot", err)
+	}
+}
@@ -0,0 +1,78 @@
+package day16
+
+import (
+	_ "embed"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/helper/dijkstra"
+	"github.com/aoc2024/helper/geom"
+	"github.com/aoc2024/helper/grid"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(16, solver.Func(solve))
+	runner.RegisterExamples(16, fixture.MustParse(examples))
+}
+
+// state is where the reindeer is and which way it faces.
+type state struct {
+	pos geom.Vec2
+	dir geom.Dir
+}
+
+func findStartEnd(maze *grid.Grid[rune]) (geom.Vec2, geom.Vec2) {
+	start, _ := grid.Find(maze, 'S')
+	end, _ := grid.Find(maze, 'E')
+	return start, end
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	if len(input) == 0 {
+		return solver.Result{}, solver.Result{}, helper.ErrEmptyInput
+	}
+	maze := grid.FromLines(input)
+	start, end := findStartEnd(maze)
+
+	// The reindeer may finish facing any way.
+	var goals []state
+	for _, d := range geom.Dirs {
+		goals = append(goals, state{end, d})
+	}
+
+	result := dijkstra.Search(dijkstra.Problem[state]{
+		// The reindeer starts facing East
+		Starts: []state{{start, geom.Right}},
+		Neighbors: func(curr state) []dijkstra.Edge[state] {
+			var edges []dijkstra.Edge[state]
+			for _, newDir := range []geom.Dir{curr.dir.TurnLeft(), curr.dir, curr.dir.TurnRight()} {
+				turnCost := 0
+				if newDir != curr.dir {
+					turnCost = 1000
+				}
+				newPos := curr.pos.Step(newDir)
+				if cell, ok := maze.Get(newPos); ok && cell != '#' {
+					edges = append(edges, dijkstra.Edge[state]{To: state{newPos, newDir}, Cost: turnCost + 1})
+				}
+			}
+			return edges
+		},
+		IsGoal: func(s state) bool { return s.pos == end },
+		// Every step costs at least 1, so the taxicab distance never
+		// overestimates.
+		Heuristic: func(s state) int { return s.pos.Manhattan(end) },
+	})
+
+	_, minEndScore := result.Nearest(goals)
+	bestPaths := make(map[geom.Vec2]bool)
+	for s := range result.OnOptimalPaths(goals) {
+		bestPaths[s.pos] = true
+	}
+	return solver.Int(minEndScore), solver.Int(len(bestPaths)), nil
+}
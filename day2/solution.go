@@ -1,13 +1,23 @@
-package main
+package day2
 
 import (
-	"fmt"
-	"strconv"
+	_ "embed"
 	"strings"
 
+	"github.com/aoc2024/fixture"
 	"github.com/aoc2024/helper"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
 )
 
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(2, solver.Func(solve))
+	runner.RegisterExamples(2, fixture.MustParse(examples))
+}
+
 func checkAdjacent(nums []int) bool {
 	for i := 1; i < len(nums); i++ {
 		diff := helper.Abs(nums[i] - nums[i-1])
@@ -64,25 +74,17 @@ func isSafeWithDampener(nums []int) bool {
 	return false
 }
 
-func solve(input []string) (int, int) {
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
 	part1, part2 := 0, 0
+	p := helper.NewParser(in.Mode)
 
-	for _, line := range input {
-		line = strings.TrimSpace(line)
-		if line == "" {
+	for i, line := range input {
+		if strings.TrimSpace(line) == "" {
 			continue
 		}
 
-		numStrs := strings.Fields(line)
-		nums := make([]int, len(numStrs))
-
-		for i, numStr := range numStrs {
-			num, err := strconv.Atoi(numStr)
-			if err != nil {
-				continue
-			}
-			nums[i] = num
-		}
+		nums := p.Ints(i+1, 1, line, "")
 
 		if isSafe(nums) {
 			part1++
@@ -92,16 +94,8 @@ func solve(input []string) (int, int) {
 		}
 	}
 
-	return part1, part2
-}
-
-func main() {
-	input, err := helper.ReadFileLineByLine("input.txt")
-	if err != nil {
-		fmt.Printf("Error reading input: %v\n", err)
+	if err := p.Err(); err != nil {
+		return solver.Result{}, solver.Result{}, err
 	}
-
-	part1, part2 := solve(input)
-	fmt.Printf("Part 1: %d\n", part1)
-	fmt.Printf("Part 2: %d\n", part2)
+	return solver.Int(part1), solver.Int(part2), nil
 }
@@ -1,14 +1,26 @@
-package main
+package day3
 
 import (
+	_ "embed"
 	"fmt"
 	"regexp"
 	"sort"
 	"strconv"
 
+	"github.com/aoc2024/fixture"
 	"github.com/aoc2024/helper"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
 )
 
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(3, solver.Func(solve))
+	runner.RegisterExamples(3, fixture.MustParse(examples))
+}
+
 type instruction struct {
 	typ      string
 	position int
@@ -96,14 +108,15 @@ func extractMultiplicationPairs(input string) ([][2]int, error) {
 	return pairs, nil
 }
 
-func solve(input []string) (int, int) {
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
 	part1, part2 := 0, 0
 	// Keep track of enable and disable from previous line
 	enabled := true
-	for _, line := range input {
+	for i, line := range input {
 		pairs, err := extractMultiplicationPairs(line)
 		if err != nil {
-			fmt.Printf("Error extracting input: %v\n", err)
+			return solver.Result{}, solver.Result{}, &helper.ParseError{Line: i + 1, Err: err}
 		}
 		for _, pair := range pairs {
 			part1 += pair[0] * pair[1]
@@ -112,16 +125,5 @@ func solve(input []string) (int, int) {
 		lineSum, enabled = processCorruptedMemory(line, enabled)
 		part2 += lineSum
 	}
-	return part1, part2
-}
-
-func main() {
-	input, err := helper.ReadFileLineByLine("input.txt")
-	if err != nil {
-		fmt.Printf("Error reading input: %v\n", err)
-	}
-
-	part1, part2 := solve(input)
-	fmt.Printf("Part 1: %d\n", part1)
-	fmt.Printf("Part 2: %d\n", part2)
+	return solver.Int(part1), solver.Int(part2), nil
 }
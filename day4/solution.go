@@ -0,0 +1,79 @@
+package day4
+
+import (
+	_ "embed"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/helper/grid"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(4, solver.Func(solve))
+	runner.RegisterExamples(4, fixture.MustParse(examples))
+}
+
+func findXMAS(g *grid.Grid[rune]) int {
+	count := 0
+
+	for p, cell := range g.All() {
+		if cell != 'X' {
+			continue
+		}
+		for _, dir := range grid.Dirs8 {
+			next := p
+			found := true
+			for _, want := range "MAS" {
+				next = next.Add(dir)
+				if c, ok := g.Get(next); !ok || c != want {
+					found = false
+					break
+				}
+			}
+			if found {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func findXMASPart2(g *grid.Grid[rune]) int {
+	count := 0
+
+	checkMS := func(p1, p2 grid.Point) bool {
+		c1, ok1 := g.Get(p1)
+		c2, ok2 := g.Get(p2)
+		return ok1 && ok2 &&
+			((c1 == 'M' && c2 == 'S') ||
+				(c1 == 'S' && c2 == 'M'))
+	}
+
+	for p, cell := range g.All() {
+		if cell != 'A' {
+			continue
+		}
+
+		if checkMS(grid.Point{X: p.X - 1, Y: p.Y - 1}, grid.Point{X: p.X + 1, Y: p.Y + 1}) &&
+			checkMS(grid.Point{X: p.X - 1, Y: p.Y + 1}, grid.Point{X: p.X + 1, Y: p.Y - 1}) {
+			count++
+		}
+	}
+	return count
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	if len(input) == 0 {
+		return solver.Result{}, solver.Result{}, helper.ErrEmptyInput
+	}
+	g := grid.FromLines(input)
+	part1 := findXMAS(g)
+	part2 := findXMASPart2(g)
+	return solver.Int(part1), solver.Int(part2), nil
+}
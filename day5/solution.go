@@ -0,0 +1,133 @@
+package day5
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/helper/topo"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(5, solver.Func(solve))
+	runner.RegisterExamples(5, fixture.MustParse(examples))
+}
+
+type rule struct {
+	before int
+	after  int
+}
+
+func parseRules(p *helper.Parser, lines []string) ([]rule, int) {
+	var rules []rule
+	rulesEndIndex := 0
+
+	for i, line := range lines {
+		if line == "" {
+			rulesEndIndex = i
+			break
+		}
+		parts := p.Split(i+1, 1, line, "|", 2)
+		before := p.Int(i+1, 1, parts[0])
+		after := p.Int(i+1, len(parts[0])+2, parts[1])
+		rules = append(rules, rule{before: before, after: after})
+	}
+	return rules, rulesEndIndex
+}
+
+func parseUpdate(p *helper.Parser, lineNum int, line string) []int {
+	return p.Ints(lineNum, 1, line, ",")
+}
+
+// ErrDuplicatePage is reported for an update listing a page twice. No order
+// of such an update can follow the rules, which say nothing about where the
+// second copy goes, so it is rejected in either parse mode.
+var ErrDuplicatePage = errors.New("page listed twice")
+
+// checkDistinct returns a *helper.ParseError pointing at the second copy of
+// the first page update, read from line, lists twice.
+func checkDistinct(lineNum int, line string, update []int) error {
+	fields := strings.Split(line, ",")
+	seen := make(map[int]bool, len(update))
+	col := 1
+	for i, page := range update {
+		if seen[page] {
+			return &helper.ParseError{Line: lineNum, Col: col, Err: fmt.Errorf("%w: %d", ErrDuplicatePage, page)}
+		}
+		seen[page] = true
+		if i < len(fields) {
+			col += len(fields[i]) + 1
+		}
+	}
+	return nil
+}
+
+// buildDependencyGraph keeps the rules about pages of the update, adding the
+// pages in update order so ties are broken the way the update had them.
+func buildDependencyGraph(rules []rule, pages []int) *topo.Graph[int] {
+	graph := topo.New[int]()
+	for _, page := range pages {
+		graph.AddNode(page)
+	}
+	for _, rule := range rules {
+		if graph.Has(rule.before) && graph.Has(rule.after) {
+			graph.AddEdge(rule.before, rule.after)
+		}
+	}
+	return graph
+}
+
+func isValidOrder(update []int, rules []rule) bool {
+	return buildDependencyGraph(rules, update).IsLinearization(update)
+}
+
+func getMiddlePage(update []int) int {
+	return update[len(update)/2]
+}
+
+// correctOrder fails with a *topo.CycleError when the rules contradict each
+// other about the pages of the update.
+func correctOrder(update []int, rules []rule) ([]int, error) {
+	return buildDependencyGraph(rules, update).Kahn()
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	part1, part2 := 0, 0
+
+	p := helper.NewParser(in.Mode)
+	rules, rulesEndIndex := parseRules(p, input)
+
+	for i := rulesEndIndex + 1; i < len(input); i++ {
+		if input[i] == "" {
+			continue
+		}
+		update := parseUpdate(p, i+1, input[i])
+		if err := checkDistinct(i+1, input[i], update); err != nil {
+			return solver.Result{}, solver.Result{}, err
+		}
+
+		if isValidOrder(update, rules) {
+			part1 += getMiddlePage(update)
+		} else {
+			correctedUpdate, err := correctOrder(update, rules)
+			if err != nil {
+				return solver.Result{}, solver.Result{}, fmt.Errorf("update on line %d: %w", i+1, err)
+			}
+			part2 += getMiddlePage(correctedUpdate)
+		}
+	}
+
+	if err := p.Err(); err != nil {
+		return solver.Result{}, solver.Result{}, err
+	}
+	return solver.Int(part1), solver.Int(part2), nil
+}
@@ -0,0 +1,29 @@
+package day5
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/solver"
+)
+
+func TestDuplicatePage(t *testing.T) {
+	lines := []string{
+		"47|53",
+		"75|47",
+		"",
+		"75,47,53",
+		"75,47,53,47",
+	}
+	for _, mode := range []helper.ParseMode{helper.Lenient, helper.Strict} {
+		_, _, err := solve(solver.Input{Lines: lines, Mode: mode})
+		var parseErr *helper.ParseError
+		if !errors.As(err, &parseErr) || !errors.Is(err, ErrDuplicatePage) {
+			t.Fatalf("mode %d: got %v, want a duplicate page error", mode, err)
+		}
+		if parseErr.Line != 5 || parseErr.Col != 10 {
+			t.Errorf("mode %d: error at line %d, column %d, want line 5, column 10", mode, parseErr.Line, parseErr.Col)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package day6
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aoc2024/helper/geom"
+	"github.com/aoc2024/helper/grid"
+)
+
+// ErrEndlessPatrol is returned when the guard never leaves the lab, even
+// without an obstacle added.
+var ErrEndlessPatrol = errors.New("the guard patrols the lab forever")
+
+// Lab is the lab floor prepared for patrols that cross it a wall at a time
+// instead of a cell at a time.
+type Lab struct {
+	width, height int
+	wall          []bool
+	// stop[d][i] is the cell a guard walking from cell i in direction d
+	// stops at, the last one before a wall, or -1 when she leaves the lab
+	// first.
+	stop [4][]int32
+}
+
+// NewLab reads the walls, '#', of lab and builds its jump tables: every row
+// and column is swept once per direction against the guard's walk,
+// carrying the cell where she would stop.
+func NewLab(lab *grid.Grid[rune]) *Lab {
+	l := &Lab{width: lab.Width, height: lab.Height, wall: make([]bool, lab.Width*lab.Height)}
+	for pos, cell := range lab.All() {
+		l.wall[l.index(pos)] = cell == '#'
+	}
+	for _, d := range geom.Dirs {
+		l.stop[d] = make([]int32, len(l.wall))
+		for i := range l.wall {
+			edge := l.point(int32(i))
+			if lab.InBounds(edge.Step(d)) {
+				continue
+			}
+			stop := int32(-1)
+			for p := edge; lab.InBounds(p); p = p.Step(d.Opposite()) {
+				if l.wall[l.index(p)] {
+					stop = l.index(p.Step(d.Opposite()))
+				} else {
+					l.stop[d][l.index(p)] = stop
+				}
+			}
+		}
+	}
+	return l
+}
+
+func (l *Lab) index(p geom.Vec2) int32 {
+	return int32(p.Y*l.width + p.X)
+}
+
+func (l *Lab) point(i int32) geom.Vec2 {
+	return geom.Vec2{X: int(i) % l.width, Y: int(i) / l.width}
+}
+
+// candidate is a cell worth putting an obstacle on, along with the state
+// the guard is in right before she first walks into it. Her walk up to then
+// is the same with the obstacle as without, so a simulation can start
+// there.
+type candidate struct {
+	obstacle geom.Vec2
+	from     state
+}
+
+// patrol walks the guard from start, a cell at a time, until she leaves
+// the lab. It returns how many cells she visits, and a candidate for every
+// one but the first.
+func (l *Lab) patrol(start geom.Vec2, dir geom.Dir) (int, []candidate, error) {
+	visited := make([]bool, len(l.wall))
+	seen := newBitset(4 * len(l.wall))
+	visited[l.index(start)] = true
+	count := 1
+	var candidates []candidate
+
+	pos := start
+	for {
+		if !seen.add(int(l.index(pos))*4 + int(dir)) {
+			return 0, nil, ErrEndlessPatrol
+		}
+		next := pos.Step(dir)
+		if next.X < 0 || next.X >= l.width || next.Y < 0 || next.Y >= l.height {
+			return count, candidates, nil
+		}
+		if l.wall[l.index(next)] {
+			dir = dir.TurnRight()
+			continue
+		}
+		if !visited[l.index(next)] {
+			visited[l.index(next)] = true
+			count++
+			candidates = append(candidates, candidate{obstacle: next, from: state{pos, dir}})
+		}
+		pos = next
+	}
+}
+
+// loops reports whether the guard, walking from c.from with an obstacle
+// added at c.obstacle, ends up walking in circles. She is only looked at
+// where she stops, so seen holds the states she stopped in; it must be
+// empty, and is left empty.
+func (l *Lab) loops(c candidate, seen *bitset) bool {
+	defer seen.reset()
+	pos, dir := l.index(c.from.pos), c.from.dir
+	for {
+		if !seen.add(int(pos)*4 + int(dir)) {
+			return true
+		}
+		stop := l.stop[dir][pos]
+		if l.blocks(pos, dir, stop, c.obstacle) {
+			stop = l.index(c.obstacle.Step(dir.Opposite()))
+		} else if stop < 0 {
+			return false
+		}
+		pos, dir = stop, dir.TurnRight()
+	}
+}
+
+// blocks reports whether obstacle stands in the way of a guard walking
+// from pos in direction dir before she reaches stop.
+func (l *Lab) blocks(pos int32, dir geom.Dir, stop int32, obstacle geom.Vec2) bool {
+	step := dir.Vec()
+	ahead := obstacle.Sub(l.point(pos))
+	if ahead.X*step.Y != ahead.Y*step.X {
+		return false
+	}
+	// Both vectors lie on the guard's line, so their dot products with
+	// step are their signed lengths along it.
+	dist := ahead.X*step.X + ahead.Y*step.Y
+	if dist <= 0 {
+		return false
+	}
+	if stop < 0 {
+		return true
+	}
+	run := l.point(stop).Sub(l.point(pos))
+	return dist <= run.X*step.X+run.Y*step.Y
+}
+
+// countLoops counts the candidates whose obstacle sends the guard in
+// circles, spread over workers goroutines, or GOMAXPROCS when workers is 0
+// or less.
+func (l *Lab) countLoops(candidates []candidate, workers int) int {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = max(1, min(workers, len(candidates)))
+
+	var count atomic.Int64
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			seen := newBitset(4 * len(l.wall))
+			n := 0
+			// Neighbouring candidates take similar walks, so striding
+			// gives every worker its share of the long ones.
+			for i := w; i < len(candidates); i += workers {
+				if l.loops(candidates[i], seen) {
+					n++
+				}
+			}
+			count.Add(int64(n))
+		}()
+	}
+	wg.Wait()
+	return int(count.Load())
+}
+
+// bitset is a set of small non-negative integers that remembers what it
+// holds, so that emptying it costs as much as filling it did.
+type bitset struct {
+	words []uint64
+	set   []int
+}
+
+func newBitset(size int) *bitset {
+	return &bitset{words: make([]uint64, (size+63)/64)}
+}
+
+// add adds i and reports whether it was missing.
+func (b *bitset) add(i int) bool {
+	word, bit := i/64, uint64(1)<<(i%64)
+	if b.words[word]&bit != 0 {
+		return false
+	}
+	b.words[word] |= bit
+	b.set = append(b.set, word)
+	return true
+}
+
+func (b *bitset) reset() {
+	for _, word := range b.set {
+		b.words[word] = 0
+	}
+	b.set = b.set[:0]
+}
@@ -0,0 +1,69 @@
+package day6
+
+import (
+	_ "embed"
+	"errors"
+	"flag"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/helper/geom"
+	"github.com/aoc2024/helper/grid"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(6, solver.Func(solve))
+	runner.RegisterExamples(6, fixture.MustParse(examples))
+	runner.RegisterFlags(6, func(fs *flag.FlagSet, prefix string) any {
+		var o options
+		fs.IntVar(&o.workers, prefix+"workers", 0, "goroutines trying obstacles (0 for GOMAXPROCS, 1 to try them in turn)")
+		return &o
+	})
+}
+
+type options struct {
+	workers int
+}
+
+// ErrNoGuard is returned for a lab with no guard drawn in it.
+var ErrNoGuard = errors.New("the lab has no guard")
+
+type state struct {
+	pos geom.Vec2
+	dir geom.Dir
+}
+
+// findGuardInitialPosition returns where the guard stands and which way she
+// faces, and false when the lab has no guard.
+func findGuardInitialPosition(lab *grid.Grid[rune]) (geom.Vec2, geom.Dir, bool) {
+	for pos, cell := range lab.All() {
+		if dir, ok := geom.ParseArrow(cell); ok {
+			return pos, dir, true
+		}
+	}
+	return geom.Vec2{}, geom.Up, false
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	if len(input) == 0 {
+		return solver.Result{}, solver.Result{}, helper.ErrEmptyInput
+	}
+	lab := grid.FromLines(input)
+	start, startDir, ok := findGuardInitialPosition(lab)
+	if !ok {
+		return solver.Result{}, solver.Result{}, ErrNoGuard
+	}
+	l := NewLab(lab)
+	part1, candidates, err := l.patrol(start, startDir)
+	if err != nil {
+		return solver.Result{}, solver.Result{}, err
+	}
+	part2 := l.countLoops(candidates, solver.OptionsOr(in, options{}).workers)
+	return solver.Int(part1), solver.Int(part2), nil
+}
@@ -0,0 +1,225 @@
+package day6
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/aoc2024/helper/geom"
+	"github.com/aoc2024/helper/grid"
+	"github.com/aoc2024/helper/randtest"
+	"github.com/aoc2024/solver"
+)
+
+// walk moves the guard one cell at a time, treating obstacle as a wall,
+// and returns the cells it visits and whether it ends up going round in a
+// loop.
+func walk(lab *grid.Grid[rune], start geom.Vec2, dir geom.Dir, obstacle geom.Vec2) (map[geom.Vec2]bool, bool) {
+	visited := map[geom.Vec2]bool{start: true}
+	seen := make(map[state]bool)
+	for pos := start; ; {
+		if seen[state{pos, dir}] {
+			return visited, true
+		}
+		seen[state{pos, dir}] = true
+		next := pos.Step(dir)
+		switch {
+		case !lab.InBounds(next):
+			return visited, false
+		case lab.At(next) == '#' || next == obstacle:
+			dir = dir.TurnRight()
+		default:
+			pos = next
+			visited[pos] = true
+		}
+	}
+}
+
+// reference counts the cells the guard visits and the cells of its path
+// where an obstacle would make it loop, walking once for every such cell.
+func reference(lab *grid.Grid[rune]) (visited, loops int) {
+	start, dir, _ := findGuardInitialPosition(lab)
+	path, _ := walk(lab, start, dir, geom.Vec2{X: -1, Y: -1})
+	for pos := range path {
+		if pos == start {
+			continue
+		}
+		if _, loop := walk(lab, start, dir, pos); loop {
+			loops++
+		}
+	}
+	return len(path), loops
+}
+
+// labs returns n random labs of up to 30 by 30 cells whose guard leaves
+// them when no obstacle is added.
+func labs(n int) [][]string {
+	return randtest.Cases(6, n, func(r *rand.Rand) []string {
+		for {
+			width, height := 1+r.IntN(30), 1+r.IntN(30)
+			density := 0.05 + 0.25*r.Float64()
+			rows := make([][]byte, height)
+			for y := range rows {
+				rows[y] = make([]byte, width)
+				for x := range rows[y] {
+					rows[y][x] = '.'
+					if r.Float64() < density {
+						rows[y][x] = '#'
+					}
+				}
+			}
+			rows[r.IntN(height)][r.IntN(width)] = "^>v<"[r.IntN(4)]
+			lines := make([]string, height)
+			for y, row := range rows {
+				lines[y] = string(row)
+			}
+			if _, _, err := patrolLab(lines, 1); err == nil {
+				return lines
+			}
+		}
+	})
+}
+
+// patrolLab counts what solve would for the lab drawn by lines.
+func patrolLab(lines []string, workers int) (visited, loops int, err error) {
+	lab := grid.FromLines(lines)
+	start, dir, ok := findGuardInitialPosition(lab)
+	if !ok {
+		return 0, 0, ErrNoGuard
+	}
+	l := NewLab(lab)
+	visited, candidates, err := l.patrol(start, dir)
+	if err != nil {
+		return 0, 0, err
+	}
+	return visited, l.countLoops(candidates, workers), nil
+}
+
+func TestMatchesReference(t *testing.T) {
+	for _, lines := range labs(200) {
+		wantVisited, wantLoops := reference(grid.FromLines(lines))
+		for _, workers := range []int{1, 3} {
+			visited, loops, err := patrolLab(lines, workers)
+			if err != nil || visited != wantVisited || loops != wantLoops {
+				t.Fatalf("%d workers, lab\n%s\nvisited %d and %d loops (%v), want %d and %d",
+					workers, strings.Join(lines, "\n"), visited, loops, err, wantVisited, wantLoops)
+			}
+		}
+	}
+}
+
+func TestSmallLabs(t *testing.T) {
+	tests := []struct {
+		name           string
+		lines          []string
+		visited, loops int
+		err            error
+	}{
+		{"one cell", []string{"^"}, 1, 0, nil},
+		{"facing out of the top edge", []string{"^.", ".."}, 1, 0, nil},
+		{"facing out of the right edge", []string{".>"}, 1, 0, nil},
+		{"along the right edge", []string{"...", "..^"}, 2, 0, nil},
+		{"turning at a wall", []string{"#", "^"}, 1, 0, nil},
+		{"one obstacle closes a loop", []string{
+			".#..",
+			"...#",
+			".^..",
+			"..#.",
+		}, 5, 1, nil},
+		{"no guard", []string{".#", ".."}, 0, 0, ErrNoGuard},
+		{"empty row", []string{""}, 0, 0, ErrNoGuard},
+	}
+	for _, tt := range tests {
+		visited, loops, err := patrolLab(tt.lines, 1)
+		if err != tt.err || visited != tt.visited || loops != tt.loops {
+			t.Errorf("%s: visited %d and %d loops (%v), want %d and %d (%v)",
+				tt.name, visited, loops, err, tt.visited, tt.loops, tt.err)
+		}
+		if _, _, err := solve(solver.Input{Lines: tt.lines}); err != tt.err {
+			t.Errorf("%s: solve returned %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestJumpTables(t *testing.T) {
+	lab := grid.FromLines([]string{
+		"#..",
+		"..#",
+		"...",
+	})
+	l := NewLab(lab)
+	tests := []struct {
+		from geom.Vec2
+		dir  geom.Dir
+		want int32
+	}{
+		{geom.Vec2{X: 0, Y: 2}, geom.Up, l.index(geom.Vec2{X: 0, Y: 1})},
+		{geom.Vec2{X: 1, Y: 2}, geom.Up, -1},
+		{geom.Vec2{X: 0, Y: 1}, geom.Right, l.index(geom.Vec2{X: 1, Y: 1})},
+		{geom.Vec2{X: 1, Y: 1}, geom.Right, l.index(geom.Vec2{X: 1, Y: 1})},
+		{geom.Vec2{X: 2, Y: 0}, geom.Left, l.index(geom.Vec2{X: 1, Y: 0})},
+		{geom.Vec2{X: 2, Y: 2}, geom.Up, l.index(geom.Vec2{X: 2, Y: 2})},
+		{geom.Vec2{X: 2, Y: 0}, geom.Down, l.index(geom.Vec2{X: 2, Y: 0})},
+		{geom.Vec2{X: 0, Y: 1}, geom.Down, -1},
+	}
+	for _, tt := range tests {
+		if got := l.stop[tt.dir][l.index(tt.from)]; got != tt.want {
+			t.Errorf("from %v heading %v: stop %d, want %d", tt.from, tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestEndlessPatrol(t *testing.T) {
+	lab := grid.FromLines([]string{
+		".#..",
+		"...#",
+		"#^..",
+		"..#.",
+	})
+	start, dir, _ := findGuardInitialPosition(lab)
+	if _, _, err := NewLab(lab).patrol(start, dir); err != ErrEndlessPatrol {
+		t.Errorf("got %v, want ErrEndlessPatrol", err)
+	}
+}
+
+func BenchmarkLoops(b *testing.B) {
+	// An open 130 by 130 lab, the size of a puzzle input, with walls
+	// scattered as sparsely as in one.
+	r := randtest.Rand(1)
+	var lines []string
+	var lab *grid.Grid[rune]
+	for {
+		lines = lines[:0]
+		for range 130 {
+			row := []byte(strings.Repeat(".", 130))
+			for x := range row {
+				if r.IntN(100) < 2 {
+					row[x] = '#'
+				}
+			}
+			lines = append(lines, string(row))
+		}
+		lines[65] = lines[65][:65] + "^" + lines[65][66:]
+		lab = grid.FromLines(lines)
+		if _, candidates, err := NewLab(lab).patrol(geom.Vec2{X: 65, Y: 65}, geom.Up); err == nil && len(candidates) > 1000 {
+			break
+		}
+	}
+	start, dir := geom.Vec2{X: 65, Y: 65}, geom.Up
+
+	b.Run("reference", func(b *testing.B) {
+		for range b.N {
+			reference(lab)
+		}
+	})
+	for _, workers := range []int{1, 4} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for range b.N {
+				l := NewLab(lab)
+				_, candidates, _ := l.patrol(start, dir)
+				l.countLoops(candidates, workers)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package day7
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// BigInverter is implemented by operators that can be undone on numbers of
+// any size. Equations whose numbers don't fit an int are solved with it.
+type BigInverter interface {
+	InverseBig(result, value *big.Int) (*big.Int, bool)
+}
+
+// BigEquation is an Equation whose numbers may be too large for an int.
+type BigEquation struct {
+	Target *big.Int
+	Values []*big.Int
+}
+
+// Small returns e as an Equation, and false when a number doesn't fit an int.
+func (e BigEquation) Small() (Equation, bool) {
+	fits := func(v *big.Int) bool {
+		return v.IsInt64() && int64(int(v.Int64())) == v.Int64()
+	}
+	if !fits(e.Target) {
+		return Equation{}, false
+	}
+	small := Equation{Target: int(e.Target.Int64()), Values: make([]int, len(e.Values))}
+	for i, v := range e.Values {
+		if !fits(v) {
+			return Equation{}, false
+		}
+		small.Values[i] = int(v.Int64())
+	}
+	return small, true
+}
+
+// SolveBackward is Equation.SolveBackward on big integers. Every operator of
+// ops must be a BigInverter.
+func (e BigEquation) SolveBackward(ops []Operator) ([]Operator, bool) {
+	if len(e.Values) == 0 {
+		return nil, false
+	}
+	inverses := make([]BigInverter, len(ops))
+	for i, op := range ops {
+		inv, ok := op.(BigInverter)
+		if !ok {
+			panic(fmt.Sprintf("day7: operator %s can't be undone on big integers", op.Symbol()))
+		}
+		inverses[i] = inv
+	}
+
+	chosen := make([]Operator, len(e.Values)-1)
+	var reduce func(index int, remaining *big.Int) bool
+	reduce = func(index int, remaining *big.Int) bool {
+		if index == 0 {
+			return remaining.Cmp(e.Values[0]) == 0
+		}
+		for i, inv := range inverses {
+			if result, ok := erases(ops[i], e.Values[index]); ok {
+				// No big total overflows, so any operators combine the
+				// earlier values into something the step then erases.
+				if remaining.Cmp(result) == 0 {
+					for j := range index {
+						chosen[j] = ops[i]
+					}
+					return true
+				}
+				continue
+			}
+			if prev, ok := inv.InverseBig(remaining, e.Values[index]); ok && reduce(index-1, prev) {
+				chosen[index-1] = ops[i]
+				return true
+			}
+		}
+		return false
+	}
+	if !reduce(len(e.Values)-1, e.Target) {
+		return nil, false
+	}
+	return chosen, true
+}
+
+// erases is Eraser.Erases for big values, which only small ones can satisfy.
+func erases(op Operator, value *big.Int) (*big.Int, bool) {
+	er, ok := op.(Eraser)
+	if !ok || !value.IsInt64() || int64(int(value.Int64())) != value.Int64() {
+		return nil, false
+	}
+	result, ok := er.Erases(int(value.Int64()))
+	return big.NewInt(int64(result)), ok
+}
+
+func (e BigEquation) Format(ops []Operator) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s = %s", e.Target, e.Values[0])
+	for i, op := range ops {
+		fmt.Fprintf(&sb, " %s %s", op.Symbol(), e.Values[i+1])
+	}
+	return sb.String()
+}
+
+func (add) InverseBig(result, value *big.Int) (*big.Int, bool) {
+	if result.Cmp(value) < 0 {
+		return nil, false
+	}
+	return new(big.Int).Sub(result, value), true
+}
+
+func (mul) InverseBig(result, value *big.Int) (*big.Int, bool) {
+	if value.Sign() == 0 {
+		return nil, false
+	}
+	q, r := new(big.Int).QuoRem(result, value, new(big.Int))
+	return q, r.Sign() == 0
+}
+
+func (concat) InverseBig(result, value *big.Int) (*big.Int, bool) {
+	sh := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(value.String()))), nil)
+	if result.Sign() < 0 {
+		return nil, false
+	}
+	q, r := new(big.Int).QuoRem(result, sh, new(big.Int))
+	return q, r.Cmp(value) == 0
+}
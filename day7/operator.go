@@ -0,0 +1,116 @@
+package day7
+
+import "github.com/aoc2024/helper"
+
+// Operator combines the running total of an equation, evaluated left to
+// right, with its next value.
+type Operator interface {
+	// Symbol is how the operator is written in an equation.
+	Symbol() string
+	// Apply returns the new total, and false when it overflows an int.
+	Apply(total, value int) (int, bool)
+	// Prune reports whether a running total of total can no longer reach
+	// target through this operator. The solver gives up on a total once
+	// every operator of the set prunes it.
+	Prune(total, target int) bool
+}
+
+// Inverter is implemented by operators that can be undone, which searching
+// backwards from the target needs.
+type Inverter interface {
+	// Inverse returns the total t such that Apply(t, value) is result, and
+	// false when there is none.
+	Inverse(result, value int) (int, bool)
+}
+
+// Eraser is implemented by operators that forget the running total for
+// some values, as multiplying by 0 does. Undoing such a step has no single
+// answer, since every total gives the same result, so searching backwards
+// treats it as reachable from whatever the earlier values add up to.
+type Eraser interface {
+	// Erases returns the result Apply(total, value) has for every total,
+	// and false when the result depends on the total.
+	Erases(value int) (int, bool)
+}
+
+// The operators of the puzzle. Their Prune methods rely on the values not
+// being negative, as in every input: none of them can then make a total
+// smaller, except multiplying by 0, which the solver checks for itself.
+var (
+	Add    Operator = add{}
+	Mul    Operator = mul{}
+	Concat Operator = concat{}
+)
+
+var (
+	part1Ops = []Operator{Mul, Add}
+	part2Ops = []Operator{Mul, Add, Concat}
+)
+
+type add struct{}
+
+func (add) Symbol() string { return "+" }
+func (add) Apply(total, value int) (int, bool) {
+	return helper.AddChecked(total, value)
+}
+func (add) Prune(total, target int) bool { return total > target }
+func (add) Inverse(result, value int) (int, bool) {
+	return result - value, result >= value
+}
+
+type mul struct{}
+
+func (mul) Symbol() string { return "*" }
+func (mul) Apply(total, value int) (int, bool) {
+	return helper.MulChecked(total, value)
+}
+func (mul) Prune(total, target int) bool { return total > target }
+func (mul) Erases(value int) (int, bool) { return 0, value == 0 }
+func (mul) Inverse(result, value int) (int, bool) {
+	if value == 0 || result%value != 0 {
+		return 0, false
+	}
+	return result / value, true
+}
+
+type concat struct{}
+
+func (concat) Symbol() string { return "||" }
+
+func (concat) Apply(total, value int) (int, bool) {
+	sh, ok := shift(value)
+	if !ok {
+		return 0, false
+	}
+	shifted, ok := helper.MulChecked(total, sh)
+	if !ok {
+		return 0, false
+	}
+	return helper.AddChecked(shifted, value)
+}
+
+func (concat) Prune(total, target int) bool { return total > target }
+
+// Inverse strips the digits of value off the end of result. Concatenating
+// onto a total of 0 leaves value as it is, so a result with no digits to
+// spare undoes to 0.
+func (concat) Inverse(result, value int) (int, bool) {
+	sh, ok := shift(value)
+	if !ok || result < 0 || result%sh != value {
+		return 0, false
+	}
+	return result / sh, true
+}
+
+// shift returns the power of ten that moves a number left by as many digits
+// as value has, and false when that power does not fit an int.
+func shift(value int) (int, bool) {
+	sh := 10
+	for v := value; v >= 10; v /= 10 {
+		var ok bool
+		if sh, ok = helper.MulChecked(sh, 10); !ok {
+			return 0, false
+		}
+	}
+	return sh, true
+}
@@ -0,0 +1,354 @@
+package day7
+
+import (
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(7, solver.Func(solve))
+	runner.RegisterExamples(7, fixture.MustParse(examples))
+	runner.RegisterFlags(7, func(fs *flag.FlagSet, prefix string) any {
+		o := defaultOptions
+		fs.BoolVar(&o.show, prefix+"show", false, "print every equation that can be made true before the answers")
+		fs.Var(&o.strategy, prefix+"strategy", "solve equations `forward` from the first value or backward from the target")
+		return &o
+	})
+}
+
+type options struct {
+	show     bool
+	strategy Strategy
+}
+
+var defaultOptions = options{strategy: Backward}
+
+// Equation is a calibration equation whose operators are missing.
+type Equation struct {
+	Target int
+	Values []int
+}
+
+type cache struct {
+	index, currentValue int
+}
+
+/**
+*Intution:
+* Part 1 uses two operators: addition (+) and multiplication (×), part 2 adds
+* concatenation (||). At each index, we must decide which operator combines the
+* current number with our running total. To find a valid solution, we explore every
+* possible combination of the operators through recursion.
+* The recurrence relation is simple: at each step, we try every operator on the
+* current number, checking if any path reaches our target. The base case occurs
+* when we reach the end of our input (index out of bounds) - we return true if the
+* current value equals the target, false otherwise.
+*
+* Approach:
+* 1. Top-Down Dynamic Programming with Memoization
+*    - Use a recursive function that tries all possible operations at each index
+*    - Cache results to avoid recomputing same states
+*
+* 2. State Parameters:
+*    - index: current position in values array
+*    - currentValue: accumulated value so far
+*    - target: target value to achieve
+*    - memo: map to store computed results
+*
+* 3. Recurrence Relation:
+*    dp(index, currentValue) =
+*        dp(index+1, op.Apply(currentValue, values[index])) for any op
+*
+* 4. Base Cases:
+*    - If index == len(values): return currentValue == target
+*    - If every operator prunes currentValue: return false (optimization)
+*
+* 5. Optimizations:
+*    - Early pruning: If currentValue > target, no need to explore further
+*    as +, * and || will only increase the value further
+*    - Memoization using a cache struct with {index, currentValue} as key
+*    - This makes it faster than bottom-up as we avoid exploring impossible paths
+*
+* 6. Time Complexity:
+*    - O(N * V) where N is length of values array and V is range of possible values
+*    - With early pruning, actual time is much less as we skip impossible paths
+*
+* 7. Space Complexity:
+*    - O(N * V) for memoization cache
+*    - O(N) recursion stack depth
+**/
+
+// SolveForward returns operators from ops, one between each pair of values,
+// that make the equation true when evaluated left to right, or false when no
+// choice does.
+func (e Equation) SolveForward(ops []Operator) ([]Operator, bool) {
+	if len(e.Values) == 0 {
+		return nil, false
+	}
+	s := &search{eq: e, ops: ops, failed: make(map[cache]bool), chosen: make([]Operator, len(e.Values)-1)}
+	// Multiplying by 0 brings any total back down, so totals past the
+	// target are only given up on once no 0 is left to come.
+	for i, v := range e.Values[1:] {
+		if v == 0 {
+			s.pruneFrom = i + 2
+		}
+	}
+	if !s.from(1, e.Values[0]) {
+		return nil, false
+	}
+	return s.chosen, true
+}
+
+type search struct {
+	eq     Equation
+	ops    []Operator
+	failed map[cache]bool
+	chosen []Operator
+	// pruneFrom is the first index from which totals may be pruned.
+	pruneFrom int
+}
+
+func (s *search) from(index, currentValue int) bool {
+	if index == len(s.eq.Values) {
+		return currentValue == s.eq.Target
+	}
+	key := cache{
+		index:        index,
+		currentValue: currentValue,
+	}
+	if s.failed[key] || (index >= s.pruneFrom && s.pruned(currentValue)) {
+		s.failed[key] = true
+		return false
+	}
+	for _, op := range s.ops {
+		// An overflowing total is past any target an int can hold.
+		next, ok := op.Apply(currentValue, s.eq.Values[index])
+		if ok && s.from(index+1, next) {
+			s.chosen[index-1] = op
+			return true
+		}
+	}
+	s.failed[key] = true
+	return false
+}
+
+func (s *search) pruned(currentValue int) bool {
+	for _, op := range s.ops {
+		if !op.Prune(currentValue, s.eq.Target) {
+			return false
+		}
+	}
+	return true
+}
+
+// SolveBackward is SolveForward working from the target back to the first
+// value, undoing one operator at a time. Undoing is far more selective than
+// applying: a product has few divisors and a concatenation only one suffix,
+// so most branches end at once and nothing needs memoizing. Every operator
+// in ops must be an Inverter.
+func (e Equation) SolveBackward(ops []Operator) ([]Operator, bool) {
+	if len(e.Values) == 0 {
+		return nil, false
+	}
+	inverses := make([]Inverter, len(ops))
+	for i, op := range ops {
+		inv, ok := op.(Inverter)
+		if !ok {
+			panic(fmt.Sprintf("day7: operator %s can't be undone", op.Symbol()))
+		}
+		inverses[i] = inv
+	}
+
+	chosen := make([]Operator, len(e.Values)-1)
+	var reduce func(index, remaining int) bool
+	reduce = func(index, remaining int) bool {
+		if index == 0 {
+			return remaining == e.Values[0]
+		}
+		for i, inv := range inverses {
+			if er, ok := ops[i].(Eraser); ok {
+				if result, ok := er.Erases(e.Values[index]); ok {
+					if remaining != result {
+						continue
+					}
+					if prefix, ok := combine(e.Values[:index], ops); ok {
+						copy(chosen, prefix)
+						chosen[index-1] = ops[i]
+						return true
+					}
+					continue
+				}
+			}
+			if prev, ok := inv.Inverse(remaining, e.Values[index]); ok && reduce(index-1, prev) {
+				chosen[index-1] = ops[i]
+				return true
+			}
+		}
+		return false
+	}
+	if !reduce(len(e.Values)-1, e.Target) {
+		return nil, false
+	}
+	return chosen, true
+}
+
+// combine returns operators from ops that combine values left to right
+// without overflowing, whatever the total, and false when every choice
+// overflows.
+func combine(values []int, ops []Operator) ([]Operator, bool) {
+	chosen := make([]Operator, len(values)-1)
+	var from func(index, total int) bool
+	from = func(index, total int) bool {
+		if index == len(values) {
+			return true
+		}
+		for _, op := range ops {
+			if next, ok := op.Apply(total, values[index]); ok && from(index+1, next) {
+				chosen[index-1] = op
+				return true
+			}
+		}
+		return false
+	}
+	return chosen, from(1, values[0])
+}
+
+// Strategy picks how equations are solved.
+type Strategy int
+
+const (
+	Backward Strategy = iota
+	Forward
+)
+
+func (s Strategy) String() string {
+	if s == Forward {
+		return "forward"
+	}
+	return "backward"
+}
+
+// Set implements flag.Value.
+func (s *Strategy) Set(name string) error {
+	switch name {
+	case "forward":
+		*s = Forward
+	case "backward":
+		*s = Backward
+	default:
+		return fmt.Errorf("unknown strategy %q, want forward or backward", name)
+	}
+	return nil
+}
+
+// Solve solves the equation with strategy. The backward strategy falls back
+// to the forward one when an operator of ops can't be undone.
+func (e Equation) Solve(ops []Operator, strategy Strategy) ([]Operator, bool) {
+	if strategy == Backward && invertible(ops) {
+		return e.SolveBackward(ops)
+	}
+	return e.SolveForward(ops)
+}
+
+func invertible(ops []Operator) bool {
+	for _, op := range ops {
+		if _, ok := op.(Inverter); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Format writes the equation with ops between its values, as in
+// "3267 = 81 + 40 * 27".
+func (e Equation) Format(ops []Operator) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d = %d", e.Target, e.Values[0])
+	for i, op := range ops {
+		fmt.Fprintf(&sb, " %s %d", op.Symbol(), e.Values[i+1])
+	}
+	return sb.String()
+}
+
+// parseEquation reads the equation on one line, at whatever size its
+// numbers need.
+func parseEquation(p *helper.Parser, lineNum int, line string) (BigEquation, error) {
+	parts := strings.Split(line, ":")
+	if len(parts) != 2 {
+		return BigEquation{}, &helper.ParseError{Line: lineNum, Err: fmt.Errorf("invalid equation %q", line)}
+	}
+	target := p.BigInt(lineNum, 1, strings.TrimSpace(parts[0]))
+	values := p.BigInts(lineNum, len(parts[0])+2, parts[1], "")
+	if len(values) == 0 {
+		return BigEquation{}, &helper.ParseError{Line: lineNum, Col: len(parts[0]) + 2, Err: errors.New("equation has no values")}
+	}
+	return BigEquation{Target: target, Values: values}, nil
+}
+
+// calibrate adds the target of eq to total when ops can make it true, and
+// writes the equation to log when opts asks for it. Equations too large for
+// an int are solved backwards on big integers, whatever the strategy.
+func calibrate(part int, total *helper.Total[int], eq BigEquation, ops []Operator, opts options, log io.Writer) {
+	var equation string
+	if small, ok := eq.Small(); ok {
+		found, ok := small.Solve(ops, opts.strategy)
+		if !ok {
+			return
+		}
+		total.Add(small.Target)
+		equation = small.Format(found)
+	} else {
+		found, ok := eq.SolveBackward(ops)
+		if !ok {
+			return
+		}
+		total.AddBig(eq.Target)
+		equation = eq.Format(found)
+	}
+	if opts.show {
+		fmt.Fprintf(log, "part %d: %s\n", part, equation)
+	}
+}
+
+func answer(total *helper.Total[int]) solver.Result {
+	if v, ok := total.Value(); ok {
+		return solver.Int(v)
+	}
+	return solver.Big(total.Big())
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	var part1, part2 helper.Total[int]
+	opts := solver.OptionsOr(in, defaultOptions)
+	p := helper.NewParser(in.Mode)
+
+	for i, line := range input {
+		if line == "" {
+			continue
+		}
+		eq, err := parseEquation(p, i+1, line)
+		if err != nil {
+			return solver.Result{}, solver.Result{}, err
+		}
+		calibrate(1, &part1, eq, part1Ops, opts, in.Logger())
+		calibrate(2, &part2, eq, part2Ops, opts, in.Logger())
+	}
+
+	if err := p.Err(); err != nil {
+		return solver.Result{}, solver.Result{}, err
+	}
+	return answer(&part1), answer(&part2), nil
+}
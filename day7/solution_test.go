@@ -0,0 +1,204 @@
+package day7
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/aoc2024/helper/randtest"
+	"github.com/aoc2024/solver"
+)
+
+// equations returns n random equations of 2 to maxValues values shaped
+// like the puzzle's, about half of which can be made true. One value in
+// ten is 0, which no input has but which multiplication makes special.
+func equations(n, maxValues int) []Equation {
+	return randtest.Cases(7, n, func(r *rand.Rand) Equation {
+		values := make([]int, 2+r.IntN(maxValues-1))
+		for j := range values {
+			if r.IntN(10) > 0 {
+				values[j] = 1 + r.IntN(999)
+			}
+		}
+		target := values[0]
+		for _, v := range values[1:] {
+			target, _ = part2Ops[r.IntN(len(part2Ops))].Apply(target, v)
+			// Keep the target within the puzzle's range, well clear of
+			// overflow.
+			if target > 1e15 {
+				target %= 1e15
+			}
+		}
+		if r.IntN(2) == 0 {
+			target++
+		}
+		return Equation{Target: target, Values: values}
+	})
+}
+
+func TestStrategiesMatchExhaustiveSearch(t *testing.T) {
+	for _, eq := range equations(200, 7) {
+		for _, ops := range [][]Operator{part1Ops, part2Ops} {
+			checkSolve(t, eq, ops, eq.exhaustive(ops))
+		}
+	}
+}
+
+func TestZeroValues(t *testing.T) {
+	tests := []struct {
+		eq           Equation
+		part1, part2 bool
+	}{
+		{Equation{Target: 0, Values: []int{5, 0}}, true, true},      // 5 * 0
+		{Equation{Target: 7, Values: []int{3, 0, 7}}, true, true},   // 3 * 0 + 7
+		{Equation{Target: 6, Values: []int{2, 0, 3}}, true, true},   // 2 + 0 * 3
+		{Equation{Target: 0, Values: []int{0, 0}}, true, true},      // 0 + 0
+		{Equation{Target: 5, Values: []int{0, 5}}, true, true},      // 0 + 5, 0 || 5
+		{Equation{Target: 10, Values: []int{1, 0}}, false, true},    // 1 || 0
+		{Equation{Target: 53, Values: []int{5, 0, 3}}, false, true}, // 5 + 0 || 3
+		{Equation{Target: 1, Values: []int{4, 0, 0}}, false, false},
+		{Equation{Target: 9, Values: []int{1 << 62, 0, 9}}, true, true}, // 2^62 * 0 + 9
+	}
+	for _, tt := range tests {
+		checkSolve(t, tt.eq, part1Ops, tt.part1)
+		checkSolve(t, tt.eq, part2Ops, tt.part2)
+	}
+}
+
+func TestSingleValue(t *testing.T) {
+	// With no operators to choose, the value must be the target.
+	checkSolve(t, Equation{Target: 7, Values: []int{7}}, part2Ops, true)
+	checkSolve(t, Equation{Target: 7, Values: []int{8}}, part2Ops, false)
+	checkSolve(t, Equation{Target: 0, Values: []int{0}}, part2Ops, true)
+}
+
+// checkSolve checks that both strategies find whether eq holds with ops,
+// and that what they find does.
+func checkSolve(t *testing.T, eq Equation, ops []Operator, want bool) {
+	t.Helper()
+	for _, strategy := range []Strategy{Forward, Backward} {
+		found, ok := eq.Solve(ops, strategy)
+		if ok != want {
+			t.Errorf("%v with %d operators: %s found %v, want %v", eq, len(ops), strategy, ok, want)
+		} else if ok && !eq.holds(found) {
+			t.Errorf("%s: %s does not hold", strategy, eq.Format(found))
+		}
+	}
+}
+
+// exhaustive tries every choice of operators.
+func (e Equation) exhaustive(ops []Operator) bool {
+	var try func(index, total int) bool
+	try = func(index, total int) bool {
+		if index == len(e.Values) {
+			return total == e.Target
+		}
+		for _, op := range ops {
+			if next, ok := op.Apply(total, e.Values[index]); ok && try(index+1, next) {
+				return true
+			}
+		}
+		return false
+	}
+	return try(1, e.Values[0])
+}
+
+func (e Equation) holds(ops []Operator) bool {
+	total := e.Values[0]
+	for i, op := range ops {
+		var ok bool
+		if total, ok = op.Apply(total, e.Values[i+1]); !ok {
+			return false
+		}
+	}
+	return total == e.Target
+}
+
+func BenchmarkSolve(b *testing.B) {
+	eqs := equations(200, 12)
+	for _, strategy := range []Strategy{Forward, Backward} {
+		b.Run(strategy.String(), func(b *testing.B) {
+			for range b.N {
+				for _, eq := range eqs {
+					eq.Solve(part2Ops, strategy)
+				}
+			}
+		})
+	}
+}
+
+func TestOverflow(t *testing.T) {
+	// 2^32 * 2^32 wraps around to 0 in an int, which would make 0 + 5 hit
+	// the target.
+	eq := Equation{Target: 5, Values: []int{1 << 32, 1 << 32, 5}}
+	for _, strategy := range []Strategy{Forward, Backward} {
+		if ops, ok := eq.Solve(part2Ops, strategy); ok {
+			t.Errorf("%s: wrapped around to %s", strategy, eq.Format(ops))
+		}
+	}
+}
+
+func TestShow(t *testing.T) {
+	input := []string{
+		"190: 10 19",
+		"3267: 81 40 27",
+		"83: 17 5",
+		"156: 15 6",
+		"7290: 6 8 6 15",
+		"161011: 16 10 13",
+		"192: 17 8 14",
+		"21037: 9 7 18 13",
+		"292: 11 6 16 20",
+	}
+	// 3267 holds both ways. Both strategies try * first, forward on the
+	// first pair of values and backward on the last.
+	for strategy, eq3267 := range map[Strategy]string{
+		Forward:  "3267 = 81 * 40 + 27",
+		Backward: "3267 = 81 + 40 * 27",
+	} {
+		var log strings.Builder
+		opts := options{show: true, strategy: strategy}
+		if _, _, err := solve(solver.Input{Lines: input, Options: &opts, Log: &log}); err != nil {
+			t.Fatal(err)
+		}
+		want := "part 1: 190 = 10 * 19\n" +
+			"part 2: 190 = 10 * 19\n" +
+			"part 1: " + eq3267 + "\n" +
+			"part 2: " + eq3267 + "\n" +
+			"part 2: 156 = 15 || 6\n" +
+			"part 2: 7290 = 6 * 8 || 6 * 15\n" +
+			"part 2: 192 = 17 || 8 + 14\n" +
+			"part 1: 292 = 11 + 6 * 16 + 20\n" +
+			"part 2: 292 = 11 + 6 * 16 + 20\n"
+		if got := log.String(); got != want {
+			t.Errorf("%s:\n%s\nwant\n%s", strategy, got, want)
+		}
+	}
+}
+
+func TestBigZero(t *testing.T) {
+	part1, _, err := solve(solver.Input{Lines: []string{"100000000000000000000: 7 0 100000000000000000000"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := part1.String(), "100000000000000000000"; got != want {
+		t.Errorf("part 1 = %s, want %s", got, want)
+	}
+}
+
+func TestBigFallback(t *testing.T) {
+	part1, part2, err := solve(solver.Input{Lines: []string{
+		"100000000000000000000: 10000000000 10000000000",
+		"1000000000010000000000: 10000000000 10000000000",
+		"3: 1 2",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := part1.String(), "100000000000000000003"; got != want {
+		t.Errorf("part 1 = %s, want %s", got, want)
+	}
+	if got, want := part2.String(), "1100000000010000000003"; got != want {
+		t.Errorf("part 2 = %s, want %s", got, want)
+	}
+}
@@ -1,17 +1,25 @@
-package main
+package day8
 
 import (
-	"fmt"
+	_ "embed"
 
+	"github.com/aoc2024/fixture"
 	"github.com/aoc2024/helper"
+	"github.com/aoc2024/helper/geom"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
 )
 
-type point struct {
-	x, y int
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(8, solver.Func(solve))
+	runner.RegisterExamples(8, fixture.MustParse(examples))
 }
 
 type antenna struct {
-	pos       point
+	pos       geom.Vec2
 	frequency rune
 }
 
@@ -21,7 +29,7 @@ func findAntennas(input []string) []antenna {
 		for x, char := range line {
 			if char != '.' {
 				antennas = append(antennas, antenna{
-					pos:       point{x, y},
+					pos:       geom.Vec2{X: x, Y: y},
 					frequency: rune(char),
 				})
 			}
@@ -30,8 +38,8 @@ func findAntennas(input []string) []antenna {
 	return antennas
 }
 
-func findAntinodes(antennas []antenna, maxX, maxY int) map[point]bool {
-	antinodes := make(map[point]bool)
+func findAntinodes(antennas []antenna, maxX, maxY int) map[geom.Vec2]bool {
+	antinodes := make(map[geom.Vec2]bool)
 	freqGroups := make(map[rune][]antenna)
 	for _, ant := range antennas {
 		freqGroups[ant.frequency] = append(freqGroups[ant.frequency], ant)
@@ -56,9 +64,10 @@ func findAntinodes(antennas []antenna, maxX, maxY int) map[point]bool {
 	return antinodes
 }
 
-func distance(p1, p2 point) float64 {
-	dx := float64(p2.x - p1.x)
-	dy := float64(p2.y - p1.y)
+func distance(p1, p2 geom.Vec2) float64 {
+	d := p2.Sub(p1)
+	dx := float64(d.X)
+	dy := float64(d.Y)
 	return dx*dx + dy*dy
 }
 
@@ -74,8 +83,8 @@ computationally expensive floating-point calculations typically required to
 determine the exact path of the line.
 **/
 
-func findPointsOnLine(p1, p2 point, maxX, maxY int) []point {
-	points := []point{}
+func findPointsOnLine(p1, p2 geom.Vec2, maxX, maxY int) []geom.Vec2 {
+	points := []geom.Vec2{}
 	/**
 		Vector Calculation: we calculate the direction vector between two points.
 		I can't add diagram here so think of it as the total horizontal and vertical
@@ -85,11 +94,11 @@ func findPointsOnLine(p1, p2 point, maxX, maxY int) []point {
 		/__|
 		dx = x2-x1
 		**/
-	dx := p2.x - p1.x
-	dy := p2.y - p1.y
+	delta := p2.Sub(p1)
+	dx, dy := delta.X, delta.Y
 
 	if dx == 0 && dy == 0 {
-		return []point{p1}
+		return []geom.Vec2{p1}
 	}
 
 	/**
@@ -102,30 +111,26 @@ func findPointsOnLine(p1, p2 point, maxX, maxY int) []point {
 			  on the line(because we are dealing with matrix indexes)
 	**/
 	g := helper.Gcd(helper.Abs(dx), helper.Abs(dy))
-	stepX := dx / g
-	stepY := dy / g
+	step := geom.Vec2{X: dx / g, Y: dy / g}
 
 	// Now I traverse in both direction to get points on both side on the line.
 	// I don't want points outside matrix so I handled that too.
-	x, y := p1.x, p1.y
-	for x >= 0 && x < maxX && y >= 0 && y < maxY {
-		points = append(points, point{x, y})
-		x -= stepX
-		y -= stepY
+	inside := func(p geom.Vec2) bool {
+		return p.X >= 0 && p.X < maxX && p.Y >= 0 && p.Y < maxY
+	}
+	for p := p1; inside(p); p = p.Sub(step) {
+		points = append(points, p)
 	}
 
-	x, y = p1.x+stepX, p1.y+stepY
-	for x >= 0 && x < maxX && y >= 0 && y < maxY {
-		points = append(points, point{x, y})
-		x += stepX
-		y += stepY
+	for p := p1.Add(step); inside(p); p = p.Add(step) {
+		points = append(points, p)
 	}
 
 	return points
 }
 
-func findAntinodesForPart2(group []antenna, maxX, maxY int) map[point]bool {
-	antinodes := make(map[point]bool)
+func findAntinodesForPart2(group []antenna, maxX, maxY int) map[geom.Vec2]bool {
+	antinodes := make(map[geom.Vec2]bool)
 	if len(group) < 2 {
 		return antinodes
 	}
@@ -141,8 +146,8 @@ func findAntinodesForPart2(group []antenna, maxX, maxY int) map[point]bool {
 	return antinodes
 }
 
-func findAntinodesP2(antennas []antenna, maxX, maxY int) map[point]bool {
-	antinodes := make(map[point]bool)
+func findAntinodesP2(antennas []antenna, maxX, maxY int) map[geom.Vec2]bool {
+	antinodes := make(map[geom.Vec2]bool)
 	freqGroups := make(map[rune][]antenna)
 
 	for _, ant := range antennas {
@@ -158,7 +163,11 @@ func findAntinodesP2(antennas []antenna, maxX, maxY int) map[point]bool {
 	return antinodes
 }
 
-func solve(input []string) (int, int) {
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	if len(input) == 0 {
+		return solver.Result{}, solver.Result{}, helper.ErrEmptyInput
+	}
 	maxY := len(input)
 	maxX := len(input[0])
 
@@ -166,15 +175,5 @@ func solve(input []string) (int, int) {
 	antinodesPart1 := findAntinodes(antennas, maxX, maxY)
 	antinodesPart2 := findAntinodesP2(antennas, maxX, maxY)
 
-	return len(antinodesPart1), len(antinodesPart2)
-}
-
-func main() {
-	input, err := helper.ReadFileLineByLine("input.txt")
-	if err != nil {
-		fmt.Printf("Error reading input: %v\n", err)
-	}
-	part1, part2 := solve(input)
-	fmt.Printf("Part 1: %d\n", part1)
-	fmt.Printf("Part 2: %d\n", part2)
+	return solver.Int(len(antinodesPart1)), solver.Int(len(antinodesPart2)), nil
 }
@@ -0,0 +1,227 @@
+package day9
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// Free is the ID of a span of free blocks.
+const Free = -1
+
+// Span is a run of Length blocks from Start, all holding the file with the
+// given ID, or free.
+type Span struct {
+	ID, Start, Length int
+}
+
+// Disk is a disk map in run-length form: its spans in order of position,
+// covering every block once. Adjacent spans never hold the same file.
+type Disk struct {
+	spans []Span
+}
+
+// ParseDisk reads a dense disk map, whose digits alternate between the
+// length of a file and the length of the free space after it.
+func ParseDisk(digits []int) *Disk {
+	d := &Disk{}
+	pos := 0
+	for i, n := range digits {
+		id := Free
+		if i%2 == 0 {
+			id = i / 2
+		}
+		d.add(Span{ID: id, Start: pos, Length: n})
+		pos += n
+	}
+	return d
+}
+
+// add appends s, merging it into the last span when it continues it.
+func (d *Disk) add(s Span) {
+	if s.Length == 0 {
+		return
+	}
+	if n := len(d.spans); n > 0 && d.spans[n-1].ID == s.ID && d.spans[n-1].Start+d.spans[n-1].Length == s.Start {
+		d.spans[n-1].Length += s.Length
+		return
+	}
+	d.spans = append(d.spans, s)
+}
+
+// Spans returns the spans of the disk in order of position.
+func (d *Disk) Spans() []Span {
+	return d.spans
+}
+
+// Size returns the number of blocks on the disk.
+func (d *Disk) Size() int {
+	if len(d.spans) == 0 {
+		return 0
+	}
+	last := d.spans[len(d.spans)-1]
+	return last.Start + last.Length
+}
+
+// Blocks returns the file ID of every block, Free for free ones.
+func (d *Disk) Blocks() []int {
+	blocks := make([]int, 0, d.Size())
+	for _, s := range d.spans {
+		for range s.Length {
+			blocks = append(blocks, s.ID)
+		}
+	}
+	return blocks
+}
+
+// Checksum adds up the position of every block times the ID of the file it
+// holds.
+func (d *Disk) Checksum() int {
+	checksum := 0
+	for _, s := range d.spans {
+		if s.ID != Free {
+			// The positions of the span add up to Length*Start plus
+			// 0 + 1 + ... + Length-1.
+			checksum += s.ID * (s.Length*s.Start + s.Length*(s.Length-1)/2)
+		}
+	}
+	return checksum
+}
+
+// CompactBlocks moves file blocks one at a time from the end of the disk to
+// the leftmost free block, until no free block is left of a file block. It
+// fills the free spans from the left with the file spans from the right in
+// a single pass, so it runs in time linear in the number of spans.
+//
+// Moving the blocks of a file span into a free span one at a time leaves
+// them in the same place as moving them all at once, so trace gets one
+// Move per such run rather than one per block.
+func (d *Disk) CompactBlocks(trace *Trace) *Disk {
+	spans := slices.Clone(d.spans)
+	out := &Disk{}
+	pos := 0
+	emit := func(id, n int) {
+		out.add(Span{ID: id, Start: pos, Length: n})
+		pos += n
+	}
+
+	i, j := 0, len(spans)-1
+	for i <= j {
+		if spans[j].ID == Free || spans[j].Length == 0 {
+			j--
+			continue
+		}
+		left := &spans[i]
+		if left.ID != Free {
+			emit(left.ID, left.Length)
+			i++
+			continue
+		}
+		// A free span at i with the last unmoved file blocks at j > i.
+		n := min(left.Length, spans[j].Length)
+		spans[j].Length -= n
+		trace.record(Move{File: spans[j].ID, From: spans[j].Start + spans[j].Length, To: pos, Length: n})
+		emit(spans[j].ID, n)
+		left.Length -= n
+		if left.Length == 0 {
+			i++
+		}
+	}
+	emit(Free, d.Size()-pos)
+	return out
+}
+
+// CompactFiles moves every file once, in order of decreasing ID, to the
+// leftmost free span left of it that can hold it whole.
+func (d *Disk) CompactFiles(trace *Trace) *Disk {
+	return d.moveFiles(trace, func(free []startHeap, f Span) int {
+		best := -1
+		for size := f.Length; size < len(free); size++ {
+			if fits(free, size, f) && (best < 0 || free[size][0] < free[best][0]) {
+				best = size
+			}
+		}
+		return best
+	})
+}
+
+// moveFiles moves every file once, in order of decreasing ID, to the free
+// span pick chooses for it, or leaves it where it is when pick returns -1.
+// Free spans are kept in one min-heap of start positions per length, which
+// pick is given indexed by length, so a choice looks at one heap top per
+// length instead of scanning the disk.
+//
+// The space a file leaves behind never needs indexing: it lies right of
+// every file still to be moved, and files only move left.
+func (d *Disk) moveFiles(trace *Trace, pick func(free []startHeap, f Span) int) *Disk {
+	var files []Span
+	maxFree := 0
+	for _, s := range d.spans {
+		if s.ID == Free {
+			maxFree = max(maxFree, s.Length)
+		} else {
+			files = append(files, s)
+		}
+	}
+	free := make([]startHeap, maxFree+1)
+	for _, s := range d.spans {
+		if s.ID == Free {
+			free[s.Length] = append(free[s.Length], s.Start)
+		}
+	}
+	for i := range free {
+		heap.Init(&free[i])
+	}
+
+	// Moving files in decreasing ID order is moving them right to left,
+	// since a parsed disk holds its files in ID order.
+	slices.SortFunc(files, func(a, b Span) int { return b.ID - a.ID })
+	for i := range files {
+		f := &files[i]
+		size := pick(free, *f)
+		if size < 0 {
+			continue
+		}
+		from := f.Start
+		f.Start = heap.Pop(&free[size]).(int)
+		trace.record(Move{File: f.ID, From: from, To: f.Start, Length: f.Length})
+		if rest := size - f.Length; rest > 0 {
+			heap.Push(&free[rest], f.Start+f.Length)
+		}
+	}
+	return layout(files, d.Size())
+}
+
+// fits reports whether the leftmost free span of the given size lies left
+// of f.
+func fits(free []startHeap, size int, f Span) bool {
+	return free[size].Len() > 0 && free[size][0] < f.Start
+}
+
+// layout returns the disk of size blocks holding files, which must not
+// overlap, and free space everywhere else.
+func layout(files []Span, size int) *Disk {
+	slices.SortFunc(files, func(a, b Span) int { return a.Start - b.Start })
+	out := &Disk{}
+	pos := 0
+	for _, f := range files {
+		out.add(Span{ID: Free, Start: pos, Length: f.Start - pos})
+		out.add(f)
+		pos = f.Start + f.Length
+	}
+	out.add(Span{ID: Free, Start: pos, Length: size - pos})
+	return out
+}
+
+// startHeap is a min-heap of span start positions.
+type startHeap []int
+
+func (h startHeap) Len() int           { return len(h) }
+func (h startHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h startHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *startHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *startHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,183 @@
+package day9
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CompactionPolicy decides how files are moved to free up the end of a
+// disk. Policies expect a disk as ParseDisk returns it, every file whole and
+// the files in ID order.
+type CompactionPolicy interface {
+	Name() string
+	// Compact returns the disk after compaction, recording every move in
+	// trace.
+	Compact(d *Disk, trace *Trace) *Disk
+}
+
+// The compaction policies. BlockByBlock and FirstFit are parts 1 and 2 of
+// the puzzle.
+var (
+	// BlockByBlock moves single blocks from the end of the disk to the
+	// leftmost free block, splitting files.
+	BlockByBlock CompactionPolicy = blockByBlock{}
+	// FirstFit moves whole files, in order of decreasing ID, to the
+	// leftmost free span left of them that can hold them.
+	FirstFit CompactionPolicy = firstFit{}
+	// BestFit moves whole files, in order of decreasing ID, to the
+	// smallest free span left of them that can hold them, the leftmost of
+	// those.
+	BestFit CompactionPolicy = bestFit{}
+	// WorstFit moves whole files, in order of decreasing ID, to the
+	// largest free span left of them, the leftmost of those.
+	WorstFit CompactionPolicy = worstFit{}
+	// Defragment slides every file left, in order of position, until it
+	// touches the one before it. It leaves no free space between files, at
+	// the cost of moving nearly every block.
+	Defragment CompactionPolicy = defragment{}
+)
+
+// Policies lists every compaction policy.
+var Policies = []CompactionPolicy{BlockByBlock, FirstFit, BestFit, WorstFit, Defragment}
+
+// PolicyByName returns the policy with the given name.
+func PolicyByName(name string) (CompactionPolicy, error) {
+	names := make([]string, len(Policies))
+	for i, p := range Policies {
+		if p.Name() == name {
+			return p, nil
+		}
+		names[i] = p.Name()
+	}
+	return nil, fmt.Errorf("unknown compaction policy %q, want one of %s", name, strings.Join(names, ", "))
+}
+
+type blockByBlock struct{}
+
+func (blockByBlock) Name() string { return "block-by-block" }
+func (blockByBlock) Compact(d *Disk, trace *Trace) *Disk {
+	return d.CompactBlocks(trace)
+}
+
+type firstFit struct{}
+
+func (firstFit) Name() string { return "first-fit" }
+func (firstFit) Compact(d *Disk, trace *Trace) *Disk {
+	return d.CompactFiles(trace)
+}
+
+type bestFit struct{}
+
+func (bestFit) Name() string { return "best-fit" }
+func (bestFit) Compact(d *Disk, trace *Trace) *Disk {
+	return d.moveFiles(trace, func(free []startHeap, f Span) int {
+		for size := f.Length; size < len(free); size++ {
+			if fits(free, size, f) {
+				return size
+			}
+		}
+		return -1
+	})
+}
+
+type worstFit struct{}
+
+func (worstFit) Name() string { return "worst-fit" }
+func (worstFit) Compact(d *Disk, trace *Trace) *Disk {
+	return d.moveFiles(trace, func(free []startHeap, f Span) int {
+		for size := len(free) - 1; size >= f.Length; size-- {
+			if fits(free, size, f) {
+				return size
+			}
+		}
+		return -1
+	})
+}
+
+type defragment struct{}
+
+func (defragment) Name() string { return "defragment" }
+func (defragment) Compact(d *Disk, trace *Trace) *Disk {
+	var files []Span
+	pos := 0
+	for _, s := range d.spans {
+		if s.ID == Free {
+			continue
+		}
+		if s.Start != pos {
+			// Everything between pos and the file is free, so it can slide
+			// there even when the two places overlap.
+			trace.record(Move{File: s.ID, From: s.Start, To: pos, Length: s.Length})
+			s.Start = pos
+		}
+		files = append(files, s)
+		pos += s.Length
+	}
+	return layout(files, d.Size())
+}
+
+// Fragmentation describes how scattered the files and the free space of a
+// disk are. A disk with every file whole and all its free space at the end
+// has none.
+type Fragmentation struct {
+	// Files is how many files are split over more than one span.
+	Files int
+	// Holes is how many runs of free blocks lie left of some file block,
+	// and HoleBlocks how many blocks they add up to.
+	Holes, HoleBlocks int
+}
+
+// Fragmentation measures how fragmented the disk is.
+func (d *Disk) Fragmentation() Fragmentation {
+	var frag Fragmentation
+	spans := make(map[int]int)
+	for i, s := range d.spans {
+		switch {
+		case s.ID != Free:
+			spans[s.ID]++
+			if spans[s.ID] == 2 {
+				frag.Files++
+			}
+		case i < len(d.spans)-1:
+			// Adjacent spans are never both free, so a free span that
+			// is not the last one has a file after it.
+			frag.Holes++
+			frag.HoleBlocks += s.Length
+		}
+	}
+	return frag
+}
+
+// Report is the outcome of compacting a disk with a policy.
+type Report struct {
+	Policy string
+	Disk   *Disk
+	Trace  *Trace
+	// Moved is how many blocks were moved; a block moved twice counts
+	// twice.
+	Moved         int
+	Fragmentation Fragmentation
+	Checksum      int
+}
+
+// Run compacts d with policy and reports on the result.
+func Run(policy CompactionPolicy, d *Disk) Report {
+	trace := &Trace{Moves: []Move{}}
+	out := policy.Compact(d, trace)
+	r := Report{
+		Policy:        policy.Name(),
+		Disk:          out,
+		Trace:         trace,
+		Fragmentation: out.Fragmentation(),
+		Checksum:      out.Checksum(),
+	}
+	for _, m := range trace.Moves {
+		r.Moved += m.Length
+	}
+	return r
+}
+
+func (r Report) String() string {
+	return fmt.Sprintf("%s: checksum %d, %d blocks moved, %d fragmented files, %d holes of %d blocks",
+		r.Policy, r.Checksum, r.Moved, r.Fragmentation.Files, r.Fragmentation.Holes, r.Fragmentation.HoleBlocks)
+}
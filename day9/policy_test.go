@@ -0,0 +1,117 @@
+package day9
+
+import (
+	"slices"
+	"testing"
+)
+
+// fitReference moves whole files on a block slice, in order of decreasing
+// ID, to the free run left of them that better prefers, scanning the disk
+// for every file.
+func fitReference(digits []int, better func(size, best int) bool) []int {
+	disk := blocksOf(digits)
+	for id := (len(digits) - 1) / 2; id >= 0; id-- {
+		start, length := slices.Index(disk, id), digits[2*id]
+		if length == 0 {
+			continue
+		}
+		best, bestSize := -1, 0
+		for i := 0; i < start; {
+			if disk[i] != Free {
+				i++
+				continue
+			}
+			size := 0
+			for i+size < start && disk[i+size] == Free {
+				size++
+			}
+			if size >= length && (best < 0 || better(size, bestSize)) {
+				best, bestSize = i, size
+			}
+			i += size
+		}
+		if best >= 0 {
+			for j := range length {
+				disk[start+j] = Free
+				disk[best+j] = id
+			}
+		}
+	}
+	return disk
+}
+
+// takeFirst never prefers a later run, so fitReference takes the first.
+func takeFirst(size, best int) bool { return false }
+
+func TestFitPoliciesMatchReference(t *testing.T) {
+	tests := []struct {
+		policy CompactionPolicy
+		better func(size, best int) bool
+	}{
+		{FirstFit, takeFirst},
+		{BestFit, func(size, best int) bool { return size < best }},
+		{WorstFit, func(size, best int) bool { return size > best }},
+	}
+	for _, tt := range tests {
+		for _, digits := range diskMaps(300) {
+			checkDisk(t, digits, tt.policy.Compact(ParseDisk(digits), nil), fitReference(digits, tt.better))
+		}
+	}
+}
+
+func TestDefragment(t *testing.T) {
+	for _, digits := range diskMaps(300) {
+		disk := blocksOf(digits)
+		want := slices.DeleteFunc(slices.Clone(disk), func(b int) bool { return b == Free })
+		for len(want) < len(disk) {
+			want = append(want, Free)
+		}
+		checkDisk(t, digits, Defragment.Compact(ParseDisk(digits), nil), want)
+	}
+}
+
+func TestPoliciesReplay(t *testing.T) {
+	for _, policy := range Policies {
+		for _, digits := range diskMaps(100) {
+			disk := ParseDisk(digits)
+			r := Run(policy, disk)
+			blocks := disk.Blocks()
+			r.Trace.Replay(blocks)
+			if want := r.Disk.Blocks(); !slices.Equal(blocks, want) {
+				t.Fatalf("%s on %v: replaying %v gives\n%v, want\n%v", policy.Name(), digits, r.Trace.Moves, blocks, want)
+			}
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	disk := ParseDisk([]int{2, 3, 3, 3, 1, 3, 3, 1, 2, 1, 4, 1, 4, 1, 3, 1, 4, 0, 2})
+	tests := []struct {
+		policy CompactionPolicy
+		moved  int
+		frag   Fragmentation
+		sum    int
+	}{
+		{BlockByBlock, 12, Fragmentation{Files: 2}, 1928},
+		{FirstFit, 8, Fragmentation{Holes: 5, HoleBlocks: 12}, 2858},
+		{Defragment, 26, Fragmentation{}, 2453},
+	}
+	for _, tt := range tests {
+		r := Run(tt.policy, disk)
+		if r.Moved != tt.moved || r.Fragmentation != tt.frag || r.Checksum != tt.sum {
+			t.Errorf("%s: moved %d, %+v, checksum %d; want %d, %+v, %d",
+				tt.policy.Name(), r.Moved, r.Fragmentation, r.Checksum, tt.moved, tt.frag, tt.sum)
+		}
+	}
+}
+
+func TestPolicyByName(t *testing.T) {
+	for _, policy := range Policies {
+		if p, err := PolicyByName(policy.Name()); err != nil || p != policy {
+			t.Errorf("PolicyByName(%q) = %v, %v", policy.Name(), p, err)
+		}
+	}
+	if _, err := PolicyByName("next-fit"); err == nil {
+		t.Error("PolicyByName(\"next-fit\") succeeded")
+	}
+}
@@ -0,0 +1,120 @@
+package day9
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+//go:embed examples.txt
+var examples string
+
+func init() {
+	runner.Register(9, solver.Func(solve))
+	runner.RegisterExamples(9, fixture.MustParse(examples))
+	runner.RegisterFlags(9, func(fs *flag.FlagSet, prefix string) any {
+		var o options
+		fs.BoolVar(&o.render, prefix+"render", false, "draw the disk before and after each compaction before the answers")
+		fs.StringVar(&o.tracePath, prefix+"trace", "", "write the moves of both compactions as JSON to `file`")
+		fs.Var(&o.policies, prefix+"policies", "comma-separated compaction `policies`, or all, to report on before the answers (and trace)")
+		return &o
+	})
+}
+
+type options struct {
+	render    bool
+	tracePath string
+	policies  policyList
+}
+
+// policyList is a flag.Value for a comma-separated list of compaction
+// policies.
+type policyList []CompactionPolicy
+
+func (l *policyList) String() string {
+	names := make([]string, len(*l))
+	for i, p := range *l {
+		names[i] = p.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+func (l *policyList) Set(s string) error {
+	if s == "all" {
+		*l = Policies
+		return nil
+	}
+	var list policyList
+	for _, name := range strings.Split(s, ",") {
+		p, err := PolicyByName(name)
+		if err != nil {
+			return err
+		}
+		list = append(list, p)
+	}
+	*l = list
+	return nil
+}
+
+func parseInput(p *helper.Parser, input string) []int {
+	return p.Digits(1, 1, input)
+}
+
+func solve(in solver.Input) (solver.Result, solver.Result, error) {
+	input := in.Lines
+	if len(input) == 0 {
+		return solver.Result{}, solver.Result{}, helper.ErrEmptyInput
+	}
+	p := helper.NewParser(in.Mode)
+	nums := parseInput(p, input[0])
+	if err := p.Err(); err != nil {
+		return solver.Result{}, solver.Result{}, err
+	}
+
+	opts := solver.OptionsOr(in, options{})
+	log := in.Logger()
+	disk := ParseDisk(nums)
+	var blocksTrace, filesTrace *Trace
+	if opts.tracePath != "" {
+		// Empty rather than nil, so that a compaction moving nothing is
+		// written as an empty list.
+		blocksTrace, filesTrace = &Trace{Moves: []Move{}}, &Trace{Moves: []Move{}}
+	}
+	blocks := disk.CompactBlocks(blocksTrace)
+	files := disk.CompactFiles(filesTrace)
+
+	if opts.render {
+		fmt.Fprintf(log, "disk:   %s\nblocks: %s\nfiles:  %s\n", disk, blocks, files)
+	}
+	traces := map[string]*Trace{"blocks": blocksTrace, "files": filesTrace}
+	for _, policy := range opts.policies {
+		r := Run(policy, disk)
+		fmt.Fprintln(log, r)
+		traces[r.Policy] = r.Trace
+	}
+	if opts.tracePath != "" {
+		if err := writeTraces(opts.tracePath, traces); err != nil {
+			return solver.Result{}, solver.Result{}, err
+		}
+	}
+	return solver.Int(blocks.Checksum()), solver.Int(files.Checksum()), nil
+}
+
+func writeTraces(path string, traces map[string]*Trace) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := WriteTraces(f, traces); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
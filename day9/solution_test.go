@@ -0,0 +1,199 @@
+package day9
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aoc2024/helper/randtest"
+)
+
+// blocksOf spells a disk map out block by block, Free for a free block.
+func blocksOf(digits []int) []int {
+	var blocks []int
+	for i, n := range digits {
+		id := Free
+		if i%2 == 0 {
+			id = i / 2
+		}
+		for range n {
+			blocks = append(blocks, id)
+		}
+	}
+	return blocks
+}
+
+// compactBlocksReference moves the last file block to the first free block
+// until no free block is left of a file block.
+func compactBlocksReference(digits []int) []int {
+	blocks := blocksOf(digits)
+	for i, j := 0, len(blocks)-1; ; {
+		for i < len(blocks) && blocks[i] != Free {
+			i++
+		}
+		for j >= 0 && blocks[j] == Free {
+			j--
+		}
+		if i >= j {
+			return blocks
+		}
+		blocks[i], blocks[j] = blocks[j], Free
+	}
+}
+
+func checksum(blocks []int) int {
+	sum := 0
+	for i, id := range blocks {
+		if id != Free {
+			sum += i * id
+		}
+	}
+	return sum
+}
+
+// diskMaps returns n random disk maps of up to 200 digits, zeros included.
+func diskMaps(n int) [][]int {
+	return randtest.Cases(9, n, func(r *rand.Rand) []int {
+		digits := make([]int, r.IntN(200))
+		for i := range digits {
+			digits[i] = r.IntN(10)
+		}
+		return digits
+	})
+}
+
+func TestCompactSmallDisks(t *testing.T) {
+	tests := []struct {
+		digits        []int
+		blocks, files string
+	}{
+		{nil, "", ""},
+		{[]int{0, 0, 0}, "", ""},
+		{[]int{3}, "000", "000"},
+		{[]int{3, 2}, "000..", "000.."},
+		{[]int{1, 1, 1}, "01.", "01."},
+		{[]int{0, 2, 1}, "1..", "1.."},
+		{[]int{0, 1, 2}, "11.", ".11"},
+		{[]int{2, 1, 3}, "00111.", "00.111"},
+	}
+	for _, tt := range tests {
+		disk := ParseDisk(tt.digits)
+		if got := disk.CompactBlocks(nil).String(); got != tt.blocks {
+			t.Errorf("disk map %v: blocks compact to %q, want %q", tt.digits, got, tt.blocks)
+		}
+		if got := disk.CompactFiles(nil).String(); got != tt.files {
+			t.Errorf("disk map %v: files compact to %q, want %q", tt.digits, got, tt.files)
+		}
+	}
+}
+
+func TestCompactBlocksMatchesReference(t *testing.T) {
+	for _, digits := range diskMaps(300) {
+		checkDisk(t, digits, ParseDisk(digits).CompactBlocks(nil), compactBlocksReference(digits))
+	}
+}
+
+func TestCompactFilesMatchesReference(t *testing.T) {
+	for _, digits := range diskMaps(300) {
+		checkDisk(t, digits, ParseDisk(digits).CompactFiles(nil), fitReference(digits, takeFirst))
+	}
+}
+
+func checkDisk(t *testing.T, digits []int, got *Disk, want []int) {
+	t.Helper()
+	if blocks := got.Blocks(); !slices.Equal(blocks, want) {
+		t.Fatalf("disk map %v:\ngot  %v\nwant %v", digits, blocks, want)
+	}
+	if sum, wantSum := got.Checksum(), checksum(want); sum != wantSum {
+		t.Fatalf("disk map %v: checksum %d, want %d", digits, sum, wantSum)
+	}
+	spans := got.Spans()
+	for i := 1; i < len(spans); i++ {
+		if spans[i].ID == spans[i-1].ID {
+			t.Fatalf("disk map %v: spans %v and %v are not merged", digits, spans[i-1], spans[i])
+		}
+	}
+}
+
+func BenchmarkCompact(b *testing.B) {
+	// 20000 digits, the size of a puzzle input.
+	r := randtest.Rand(1)
+	digits := make([]int, 20000)
+	for i := range digits {
+		digits[i] = 1 + r.IntN(9)
+	}
+	disk := ParseDisk(digits)
+	for _, mode := range []struct {
+		name    string
+		compact func(*Disk, *Trace) *Disk
+	}{
+		{"blocks", (*Disk).CompactBlocks},
+		{"files", (*Disk).CompactFiles},
+	} {
+		b.Run(mode.name, func(b *testing.B) {
+			for range b.N {
+				mode.compact(disk, nil).Checksum()
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		disk *Disk
+		want string
+	}{
+		{ParseDisk([]int{1, 2, 3, 4, 5}), "0..111....22222"},
+		{ParseDisk([]int{1, 2, 3, 4, 5}).CompactBlocks(nil), "022111222......"},
+		{
+			ParseDisk([]int{2, 3, 3, 3, 1, 3, 3, 1, 2, 1, 4, 1, 4, 1, 3, 1, 4, 0, 2}).CompactFiles(nil),
+			"00992111777.44.333....5555.6666.....8888..",
+		},
+		{ParseDisk([]int{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 1, 1}), "0[10][10].[11]"},
+	}
+	for _, tt := range tests {
+		if got := tt.disk.String(); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestTraceReplays(t *testing.T) {
+	for _, digits := range diskMaps(100) {
+		disk := ParseDisk(digits)
+		for _, compact := range []func(*Disk, *Trace) *Disk{(*Disk).CompactBlocks, (*Disk).CompactFiles} {
+			var trace Trace
+			want := compact(disk, &trace).Blocks()
+			blocks := disk.Blocks()
+			trace.Replay(blocks)
+			if !slices.Equal(blocks, want) {
+				t.Fatalf("disk map %v: replaying %v gives\n%v, want\n%v", digits, trace.Moves, blocks, want)
+			}
+		}
+	}
+}
+
+func TestWriteTraces(t *testing.T) {
+	trace := Trace{Moves: []Move{{File: 2, From: 10, To: 1, Length: 2}}}
+	var b strings.Builder
+	if err := WriteTraces(&b, map[string]*Trace{"files": &trace}); err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+  "files": {
+    "moves": [
+      {
+        "file": 2,
+        "from": 10,
+        "to": 1,
+        "length": 2
+      }
+    ]
+  }
+}
+`
+	if b.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", b.String(), want)
+	}
+}
@@ -0,0 +1,69 @@
+package day9
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// String draws the disk the way the puzzle does, one character per block
+// and '.' for free ones, as in "00...111...2...333". Files with an ID above
+// 9 need more than one character, so they are drawn bracketed, one block at
+// a time: a two-block file 12 is "[12][12]".
+func (d *Disk) String() string {
+	var b strings.Builder
+	for _, s := range d.spans {
+		var block string
+		switch {
+		case s.ID == Free:
+			block = "."
+		case s.ID <= 9:
+			block = strconv.Itoa(s.ID)
+		default:
+			block = "[" + strconv.Itoa(s.ID) + "]"
+		}
+		b.WriteString(strings.Repeat(block, s.Length))
+	}
+	return b.String()
+}
+
+// Move is Length blocks of a file moving from block From to block To.
+type Move struct {
+	File   int `json:"file"`
+	From   int `json:"from"`
+	To     int `json:"to"`
+	Length int `json:"length"`
+}
+
+// Trace records the moves a compaction makes, in the order it makes them.
+// A nil *Trace records nothing.
+type Trace struct {
+	Moves []Move `json:"moves"`
+}
+
+func (t *Trace) record(m Move) {
+	if t != nil {
+		t.Moves = append(t.Moves, m)
+	}
+}
+
+// Replay applies the moves to blocks, as returned by Disk.Blocks.
+func (t *Trace) Replay(blocks []int) {
+	for _, m := range t.Moves {
+		for i := range m.Length {
+			blocks[m.From+i] = Free
+		}
+		for i := range m.Length {
+			blocks[m.To+i] = m.File
+		}
+	}
+}
+
+// WriteTraces writes traces as one indented JSON object, keyed by the name
+// of the compaction each one recorded.
+func WriteTraces(w io.Writer, traces map[string]*Trace) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(traces)
+}
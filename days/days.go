@@ -0,0 +1,21 @@
+// Package days links every day's solver into the runner registry.
+package days
+
+import (
+	_ "github.com/aoc2024/day1"
+	_ "github.com/aoc2024/day10"
+	_ "github.com/aoc2024/day11"
+	_ "github.com/aoc2024/day12"
+	_ "github.com/aoc2024/day13"
+	_ "github.com/aoc2024/day14"
+	_ "github.com/aoc2024/day15"
+	_ "github.com/aoc2024/day16"
+	_ "github.com/aoc2024/day2"
+	_ "github.com/aoc2024/day3"
+	_ "github.com/aoc2024/day4"
+	_ "github.com/aoc2024/day5"
+	_ "github.com/aoc2024/day6"
+	_ "github.com/aoc2024/day7"
+	_ "github.com/aoc2024/day8"
+	_ "github.com/aoc2024/day9"
+)
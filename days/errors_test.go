@@ -0,0 +1,56 @@
+package days
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+// TestMalformedInput makes sure no day panics on input it can't make sense
+// of. Returning an error or a meaningless answer are both fine.
+func TestMalformedInput(t *testing.T) {
+	inputs := map[string][]string{
+		"empty":      nil,
+		"garbage":    {"x"},
+		"blank line": {""},
+		// A robot and boxes with no walls to stop them at the map's edge.
+		"no walls":       {"@", "", "<<"},
+		"no walls boxes": {"O@O", ".O.", "", "<<>>^^vv"},
+	}
+	for _, day := range runner.Days() {
+		s, _ := runner.Lookup(day)
+		for name, input := range inputs {
+			t.Run(fmt.Sprintf("day%d/%s", day, name), func(t *testing.T) {
+				defer func() {
+					if r := recover(); r != nil {
+						t.Errorf("panic: %v", r)
+					}
+				}()
+				s.Solve(solver.Input{Lines: input})
+			})
+		}
+	}
+}
+
+// TestParseModes runs the same malformed input in both modes at once, which
+// only works if neither run's mode leaks into the other.
+func TestParseModes(t *testing.T) {
+	s, _ := runner.Lookup(2)
+	input := []string{"1 2 x 4"}
+	for _, mode := range []helper.ParseMode{helper.Lenient, helper.Strict, helper.Lenient, helper.Strict} {
+		t.Run(fmt.Sprint(mode), func(t *testing.T) {
+			t.Parallel()
+			for range 100 {
+				_, _, err := s.Solve(solver.Input{Lines: input, Mode: mode})
+				var parseErr *helper.ParseError
+				if got, want := errors.As(err, &parseErr), mode == helper.Strict; got != want {
+					t.Fatalf("mode %d: got error %v", mode, err)
+				}
+			}
+		})
+	}
+}
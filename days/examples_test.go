@@ -0,0 +1,18 @@
+package days
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aoc2024/fixture/fixturetest"
+	"github.com/aoc2024/runner"
+)
+
+func TestExamples(t *testing.T) {
+	for _, day := range runner.Days() {
+		t.Run(fmt.Sprintf("day%d", day), func(t *testing.T) {
+			s, _ := runner.Lookup(day)
+			fixturetest.Check(t, s, runner.Examples(day))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package days
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/runner"
+	"github.com/aoc2024/solver"
+)
+
+// Run `go test ./days -update` to rewrite the golden file once a changed
+// answer has been verified.
+var update = flag.Bool("update", false, "rewrite testdata/answers.json with the current answers")
+
+const goldenFile = "testdata/answers.json"
+
+// answer holds the expected answers of one day. A nil part is one that is
+// not implemented.
+type answer struct {
+	Part1 *string `json:"part1"`
+	Part2 *string `json:"part2"`
+}
+
+func newAnswer(part1, part2 solver.Result) answer {
+	encode := func(r solver.Result) *string {
+		if !r.Implemented() {
+			return nil
+		}
+		s := r.String()
+		return &s
+	}
+	return answer{Part1: encode(part1), Part2: encode(part2)}
+}
+
+func (a answer) String() string {
+	decode := func(s *string) string {
+		if s == nil {
+			return solver.NotImplemented().String()
+		}
+		return *s
+	}
+	return fmt.Sprintf("part 1 = %s, part 2 = %s", decode(a.Part1), decode(a.Part2))
+}
+
+func readGolden(t *testing.T) map[string]answer {
+	t.Helper()
+	data, err := os.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatalf("reading golden file: %v", err)
+	}
+	golden := make(map[string]answer)
+	if err := json.Unmarshal(data, &golden); err != nil {
+		t.Fatalf("parsing golden file: %v", err)
+	}
+	return golden
+}
+
+func writeGolden(t *testing.T, golden map[string]answer) {
+	t.Helper()
+	data, err := json.MarshalIndent(golden, "", "  ")
+	if err != nil {
+		t.Fatalf("encoding golden file: %v", err)
+	}
+	if err := os.WriteFile(goldenFile, append(data, '\n'), 0o644); err != nil {
+		t.Fatalf("writing golden file: %v", err)
+	}
+}
+
+func TestGoldenAnswers(t *testing.T) {
+	golden := make(map[string]answer)
+	if !*update {
+		golden = readGolden(t)
+	}
+
+	for _, day := range runner.Days() {
+		name := fmt.Sprintf("day%d", day)
+		t.Run(name, func(t *testing.T) {
+			s, _ := runner.Lookup(day)
+			input, err := helper.ReadFileLineByLine(filepath.Join("..", name, "input.txt"))
+			if err != nil {
+				t.Fatalf("reading input: %v", err)
+			}
+
+			part1, part2, err := s.Solve(solver.Input{Lines: input})
+			if err != nil {
+				t.Fatalf("Solve: %v", err)
+			}
+			got := newAnswer(part1, part2)
+			if *update {
+				golden[name] = got
+				return
+			}
+
+			want, ok := golden[name]
+			if !ok {
+				t.Fatalf("no golden answer for %s, got %s", name, got)
+			}
+			if got.String() != want.String() {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+
+	if *update {
+		writeGolden(t, golden)
+	}
+}
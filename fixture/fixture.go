@@ -0,0 +1,96 @@
+// Package fixture reads the example inputs from the puzzle text together with
+// their expected answers.
+//
+// A fixture file holds any number of examples. Each one starts with a
+// "=== name" line followed by its expected answers, then a "---" line and
+// the example input:
+//
+//	# Lines starting with '#' before the first example are comments.
+//	=== larger example
+//	part1: 1930
+//	part2: 1206
+//	---
+//	RRRRIICCFF
+//	...
+//
+// A part without an expected answer is not checked. Blank lines at the end
+// of an input are dropped, the same way they are when reading input.txt.
+package fixture
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Fixture struct {
+	Name  string
+	Input []string
+	// Part1 and Part2 are the expected answers, empty when not checked.
+	Part1, Part2 string
+}
+
+func Parse(data string) ([]Fixture, error) {
+	var fixtures []Fixture
+	var current *Fixture
+	inHeader := false
+
+	for i, line := range strings.Split(data, "\n") {
+		if name, ok := strings.CutPrefix(line, "=== "); ok {
+			if inHeader {
+				return nil, fmt.Errorf("line %d: example %q has no input", i+1, current.Name)
+			}
+			fixtures = append(fixtures, Fixture{Name: strings.TrimSpace(name)})
+			current = &fixtures[len(fixtures)-1]
+			inHeader = true
+			continue
+		}
+		if current == nil {
+			if line != "" && !strings.HasPrefix(line, "#") {
+				return nil, fmt.Errorf("line %d: expected '=== name', got %q", i+1, line)
+			}
+			continue
+		}
+		if !inHeader {
+			current.Input = append(current.Input, line)
+			continue
+		}
+
+		if line == "---" {
+			inHeader = false
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected 'part1: answer', got %q", i+1, line)
+		}
+		switch strings.TrimSpace(key) {
+		case "part1":
+			current.Part1 = strings.TrimSpace(value)
+		case "part2":
+			current.Part2 = strings.TrimSpace(value)
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q", i+1, key)
+		}
+	}
+
+	if inHeader {
+		return nil, fmt.Errorf("example %q has no input", current.Name)
+	}
+	for i := range fixtures {
+		f := &fixtures[i]
+		for len(f.Input) > 0 && f.Input[len(f.Input)-1] == "" {
+			f.Input = f.Input[:len(f.Input)-1]
+		}
+	}
+	return fixtures, nil
+}
+
+// MustParse is Parse for fixture files embedded in a day's package, where a
+// malformed file is a programming error.
+func MustParse(data string) []Fixture {
+	fixtures, err := Parse(data)
+	if err != nil {
+		panic("fixture: " + err.Error())
+	}
+	return fixtures
+}
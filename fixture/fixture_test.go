@@ -0,0 +1,54 @@
+package fixture
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	data := `# comment
+=== first
+part1: 11
+---
+a
+b
+
+=== second
+part2: x y
+---
+
+c
+
+`
+	fixtures, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := []Fixture{
+		{Name: "first", Input: []string{"a", "b"}, Part1: "11"},
+		{Name: "second", Input: []string{"", "c"}, Part2: "x y"},
+	}
+	if len(fixtures) != len(want) {
+		t.Fatalf("got %d fixtures, want %d", len(fixtures), len(want))
+	}
+	for i, f := range fixtures {
+		w := want[i]
+		if f.Name != w.Name || f.Part1 != w.Part1 || f.Part2 != w.Part2 || !slices.Equal(f.Input, w.Input) {
+			t.Errorf("fixture %d = %+v, want %+v", i, f, w)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, data := range []string{
+		"stray line\n=== a\n---\nx\n",
+		"=== a\npart1: 1\n=== b\n---\nx\n",
+		"=== a\npart1: 1\n",
+		"=== a\npart3: 1\n---\nx\n",
+		"=== a\nnot a key\n---\nx\n",
+	} {
+		if _, err := Parse(data); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", data)
+		}
+	}
+}
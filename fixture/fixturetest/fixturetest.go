@@ -0,0 +1,33 @@
+// Package fixturetest checks solvers against their fixtures from tests. It
+// is kept apart from package fixture so that the commands, which read
+// fixtures for -example, don't link package testing.
+package fixturetest
+
+import (
+	"testing"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/solver"
+)
+
+// Check runs s on every fixture and reports the answers that don't match.
+// Fixtures are parsed strictly, so a malformed one fails rather than being
+// read as zeros.
+func Check(t *testing.T, s solver.Solver, fixtures []fixture.Fixture) {
+	t.Helper()
+	for _, f := range fixtures {
+		t.Run(f.Name, func(t *testing.T) {
+			part1, part2, err := s.Solve(solver.Input{Lines: f.Input, Mode: helper.Strict})
+			if err != nil {
+				t.Fatalf("Solve: %v", err)
+			}
+			if f.Part1 != "" && part1.String() != f.Part1 {
+				t.Errorf("part 1 = %s, want %s", part1, f.Part1)
+			}
+			if f.Part2 != "" && part2.String() != f.Part2 {
+				t.Errorf("part 2 = %s, want %s", part2, f.Part2)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package helper
+
+import "math/big"
+
+// AddChecked returns a+b, and false when the sum does not fit T.
+func AddChecked[T Integer](a, b T) (T, bool) {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return c, false
+	}
+	return c, true
+}
+
+// SubChecked returns a-b, and false when the difference does not fit T.
+func SubChecked[T Integer](a, b T) (T, bool) {
+	c := a - b
+	if (b > 0 && c > a) || (b < 0 && c < a) {
+		return c, false
+	}
+	return c, true
+}
+
+// MulChecked returns a*b, and false when the product does not fit T.
+func MulChecked[T Integer](a, b T) (T, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	// The sign test catches the most negative value times -1, which the
+	// division alone misses.
+	if c/b != a || ((a < 0) != (b < 0)) != (c < 0) {
+		return c, false
+	}
+	return c, true
+}
+
+// ToBig returns v as a big.Int.
+func ToBig[T Integer](v T) *big.Int {
+	if ^T(0) < 0 {
+		return big.NewInt(int64(v))
+	}
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+// Total is a running sum kept in T until it overflows, and in a big.Int
+// from then on. The zero value is an empty sum.
+type Total[T Integer] struct {
+	n   T
+	big *big.Int
+}
+
+func (t *Total[T]) Add(v T) {
+	if t.big == nil {
+		if sum, ok := AddChecked(t.n, v); ok {
+			t.n = sum
+			return
+		}
+		t.big = ToBig(t.n)
+	}
+	t.big.Add(t.big, ToBig(v))
+}
+
+func (t *Total[T]) AddBig(v *big.Int) {
+	if t.big == nil {
+		t.big = ToBig(t.n)
+	}
+	t.big.Add(t.big, v)
+}
+
+// Value returns the sum, and false once it has outgrown T.
+func (t *Total[T]) Value() (T, bool) {
+	if t.big != nil {
+		return 0, false
+	}
+	return t.n, true
+}
+
+// Big returns the sum as a new big.Int.
+func (t *Total[T]) Big() *big.Int {
+	if t.big != nil {
+		return new(big.Int).Set(t.big)
+	}
+	return ToBig(t.n)
+}
@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChecked(t *testing.T) {
+	if _, ok := AddChecked[int64](math.MaxInt64, 1); ok {
+		t.Error("MaxInt64 + 1 did not overflow")
+	}
+	if v, ok := AddChecked[int64](math.MaxInt64, -1); !ok || v != math.MaxInt64-1 {
+		t.Errorf("MaxInt64 - 1 = %d, %v", v, ok)
+	}
+	if _, ok := SubChecked[uint64](0, 1); ok {
+		t.Error("0 - 1 did not overflow a uint64")
+	}
+	if _, ok := MulChecked[uint64](math.MaxUint64/2024+1, 2024); ok {
+		t.Error("MulChecked missed a uint64 overflow")
+	}
+	if _, ok := MulChecked[int64](math.MinInt64, -1); ok {
+		t.Error("MinInt64 * -1 did not overflow")
+	}
+	if _, ok := MulChecked[int64](-1, math.MinInt64); ok {
+		t.Error("-1 * MinInt64 did not overflow")
+	}
+	if v, ok := MulChecked[int8](-8, 16); !ok || v != -128 {
+		t.Errorf("-8 * 16 = %d, %v, want -128", v, ok)
+	}
+}
+
+func TestTotal(t *testing.T) {
+	var total Total[uint64]
+	total.Add(math.MaxUint64)
+	if v, ok := total.Value(); !ok || v != math.MaxUint64 {
+		t.Fatalf("Value = %d, %v", v, ok)
+	}
+	total.Add(2)
+	if _, ok := total.Value(); ok {
+		t.Error("Value still fits after overflowing")
+	}
+	if got, want := total.Big().String(), "18446744073709551617"; got != want {
+		t.Errorf("Big = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,207 @@
+// Package dijkstra finds weighted shortest paths over implicit graphs, with
+// an optional A* heuristic. Besides the distances it keeps every optimal
+// predecessor of a state, so questions about all the optimal paths, not just
+// one of them, are answered from the same search.
+package dijkstra
+
+import (
+	"container/heap"
+	"slices"
+)
+
+// Edge leads to To at a Cost that must not be negative. CountPaths also
+// needs it to be positive.
+type Edge[S comparable] struct {
+	To   S
+	Cost int
+}
+
+// Problem describes a search over states of type S.
+type Problem[S comparable] struct {
+	// Starts are the states the search begins from, all at distance 0.
+	Starts []S
+	// Neighbors returns the edges leaving a state.
+	Neighbors func(S) []Edge[S]
+	// IsGoal is optional. When set, the search stops once every state that
+	// can be reached as cheaply as the nearest goal has been settled.
+	IsGoal func(S) bool
+	// Heuristic is optional and turns the search into A*. It must never
+	// overestimate the distance to a goal and must be consistent, otherwise
+	// distances and predecessors may not be optimal.
+	Heuristic func(S) int
+}
+
+// Result holds the distance of every settled state and, for each of them,
+// every predecessor on an optimal path from a start.
+type Result[S comparable] struct {
+	dist  map[S]int
+	preds map[S][]S
+	order []S // settled states in the order they were settled
+}
+
+type item[S comparable] struct {
+	state    S
+	dist     int
+	priority int
+}
+
+type queue[S comparable] []item[S]
+
+func (q queue[S]) Len() int           { return len(q) }
+func (q queue[S]) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q queue[S]) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *queue[S]) Push(x any)        { *q = append(*q, x.(item[S])) }
+func (q *queue[S]) Pop() any {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
+
+// Search runs the search described by p.
+func Search[S comparable](p Problem[S]) *Result[S] {
+	r := &Result[S]{dist: make(map[S]int), preds: make(map[S][]S)}
+	h := func(s S) int {
+		if p.Heuristic == nil {
+			return 0
+		}
+		return p.Heuristic(s)
+	}
+
+	best := make(map[S]int)
+	q := &queue[S]{}
+	for _, s := range p.Starts {
+		if _, seen := best[s]; !seen {
+			best[s] = 0
+			heap.Push(q, item[S]{s, 0, h(s)})
+		}
+	}
+
+	goalDist := -1
+	for q.Len() > 0 {
+		it := heap.Pop(q).(item[S])
+		if _, settled := r.dist[it.state]; settled || it.dist > best[it.state] {
+			continue
+		}
+		if goalDist >= 0 && it.priority > goalDist {
+			break
+		}
+		r.dist[it.state] = it.dist
+		r.order = append(r.order, it.state)
+		if p.IsGoal != nil && p.IsGoal(it.state) && goalDist < 0 {
+			goalDist = it.dist
+		}
+
+		for _, e := range p.Neighbors(it.state) {
+			d := it.dist + e.Cost
+			if settledDist, settled := r.dist[e.To]; settled {
+				// With A*, a state can be settled before all of its equally
+				// good predecessors are.
+				if d == settledDist {
+					r.preds[e.To] = append(r.preds[e.To], it.state)
+				}
+				continue
+			}
+			prev, seen := best[e.To]
+			switch {
+			case !seen || d < prev:
+				best[e.To] = d
+				r.preds[e.To] = []S{it.state}
+				heap.Push(q, item[S]{e.To, d, d + h(e.To)})
+			case d == prev:
+				r.preds[e.To] = append(r.preds[e.To], it.state)
+			}
+		}
+	}
+	return r
+}
+
+// Distance returns the distance of s from the nearest start, and whether s
+// was reached.
+func (r *Result[S]) Distance(s S) (int, bool) {
+	d, ok := r.dist[s]
+	return d, ok
+}
+
+// Nearest returns those of targets that were reached at the smallest
+// distance, and that distance. It returns -1 when none was reached.
+func (r *Result[S]) Nearest(targets []S) ([]S, int) {
+	var nearest []S
+	bestDist := -1
+	for _, t := range targets {
+		d, ok := r.dist[t]
+		switch {
+		case !ok:
+		case bestDist < 0 || d < bestDist:
+			nearest, bestDist = []S{t}, d
+		case d == bestDist && !slices.Contains(nearest, t):
+			nearest = append(nearest, t)
+		}
+	}
+	return nearest, bestDist
+}
+
+// Predecessors returns the states that reach s on an optimal path.
+func (r *Result[S]) Predecessors(s S) []S {
+	return r.preds[s]
+}
+
+// OnOptimalPaths returns every state that lies on an optimal path from a
+// start to any of the nearest of targets, the targets included.
+func (r *Result[S]) OnOptimalPaths(targets []S) map[S]bool {
+	nearest, _ := r.Nearest(targets)
+	on := make(map[S]bool)
+	stack := append([]S(nil), nearest...)
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if on[s] {
+			continue
+		}
+		on[s] = true
+		stack = append(stack, r.preds[s]...)
+	}
+	return on
+}
+
+// CountPaths returns how many distinct optimal paths lead from a start to
+// the nearest of targets.
+func (r *Result[S]) CountPaths(targets []S) int {
+	nearest, _ := r.Nearest(targets)
+	// Predecessors are strictly closer than the states they lead to, so
+	// going by distance sees every count before it is needed.
+	order := slices.Clone(r.order)
+	slices.SortStableFunc(order, func(a, b S) int { return r.dist[a] - r.dist[b] })
+	counts := make(map[S]int)
+	for _, s := range order {
+		preds := r.preds[s]
+		if len(preds) == 0 {
+			counts[s] = 1 // a start
+			continue
+		}
+		for _, p := range preds {
+			counts[s] += counts[p]
+		}
+	}
+
+	total := 0
+	for _, t := range nearest {
+		total += counts[t]
+	}
+	return total
+}
+
+// Path returns one optimal path from a start to target, or nil when target
+// was not reached.
+func (r *Result[S]) Path(target S) []S {
+	if _, ok := r.dist[target]; !ok {
+		return nil
+	}
+	path := []S{target}
+	for s := target; len(r.preds[s]) > 0; {
+		s = r.preds[s][0]
+		path = append(path, s)
+	}
+	slices.Reverse(path)
+	return path
+}
@@ -0,0 +1,67 @@
+package dijkstra
+
+import (
+	"slices"
+	"testing"
+)
+
+// diamond has two optimal routes from a to d, through b and through c, and
+// a dearer direct edge.
+var diamond = map[string][]Edge[string]{
+	"a": {{To: "b", Cost: 1}, {To: "c", Cost: 2}, {To: "d", Cost: 5}},
+	"b": {{To: "d", Cost: 3}},
+	"c": {{To: "d", Cost: 2}},
+	"d": {{To: "e", Cost: 1}},
+}
+
+func search(heuristic func(string) int) *Result[string] {
+	return Search(Problem[string]{
+		Starts:    []string{"a"},
+		Neighbors: func(s string) []Edge[string] { return diamond[s] },
+		IsGoal:    func(s string) bool { return s == "d" },
+		Heuristic: heuristic,
+	})
+}
+
+func TestAllOptimalPaths(t *testing.T) {
+	for name, h := range map[string]func(string) int{
+		"dijkstra": nil,
+		"astar":    func(s string) int { return map[string]int{"a": 4, "b": 3, "c": 2}[s] },
+	} {
+		r := search(h)
+		if d, ok := r.Distance("d"); !ok || d != 4 {
+			t.Errorf("%s: Distance(d) = %d, %v, want 4, true", name, d, ok)
+		}
+		if _, ok := r.Distance("e"); ok {
+			t.Errorf("%s: e was settled past the goal", name)
+		}
+		if n := r.CountPaths([]string{"d"}); n != 2 {
+			t.Errorf("%s: CountPaths = %d, want 2", name, n)
+		}
+		on := r.OnOptimalPaths([]string{"d"})
+		if len(on) != 4 || !on["b"] || !on["c"] {
+			t.Errorf("%s: OnOptimalPaths = %v, want a, b, c and d", name, on)
+		}
+		path := r.Path("d")
+		if len(path) != 3 || path[0] != "a" || path[2] != "d" {
+			t.Errorf("%s: Path(d) = %v, want a 3-state path from a to d", name, path)
+		}
+	}
+}
+
+func TestNearest(t *testing.T) {
+	r := Search(Problem[string]{
+		Starts:    []string{"a"},
+		Neighbors: func(s string) []Edge[string] { return diamond[s] },
+	})
+	nearest, d := r.Nearest([]string{"e", "c", "b", "z"})
+	if d != 1 || !slices.Equal(nearest, []string{"b"}) {
+		t.Errorf("Nearest = %v, %d, want [b], 1", nearest, d)
+	}
+	if _, d := r.Nearest([]string{"z"}); d != -1 {
+		t.Errorf("Nearest(unreachable) distance = %d, want -1", d)
+	}
+	if r.Path("z") != nil {
+		t.Error("Path(unreachable) is not nil")
+	}
+}
@@ -0,0 +1,27 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrEmptyInput is returned by solvers that need at least one line of input.
+var ErrEmptyInput = errors.New("empty input")
+
+// ParseError reports a malformed piece of input. Line and Col count from 1;
+// a Col of 0 means the error applies to the whole line.
+type ParseError struct {
+	Line, Col int
+	Err       error
+}
+
+func (e *ParseError) Error() string {
+	if e.Col == 0 {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Col, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
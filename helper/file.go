@@ -3,6 +3,7 @@ package helper
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 )
 
@@ -13,14 +14,18 @@ func ReadFileLineByLine(filename string) ([]string, error) {
 	}
 	defer file.Close()
 
+	return ReadLines(file)
+}
+
+func ReadLines(r io.Reader) ([]string, error) {
 	var output []string
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
 		output = append(output, scanner.Text())
 	}
 
 	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error scanning file: %v", err)
+		return nil, fmt.Errorf("error scanning input: %v", err)
 	}
 
 	return output, nil
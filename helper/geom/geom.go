@@ -0,0 +1,110 @@
+// Package geom has the 2D vector and direction algebra shared by the grid
+// puzzles. Y grows downwards, the way the puzzle maps are drawn.
+package geom
+
+import (
+	"fmt"
+
+	"github.com/aoc2024/helper"
+)
+
+type Vec2 struct {
+	X, Y int
+}
+
+func (v Vec2) Add(w Vec2) Vec2 {
+	return Vec2{v.X + w.X, v.Y + w.Y}
+}
+
+func (v Vec2) Sub(w Vec2) Vec2 {
+	return Vec2{v.X - w.X, v.Y - w.Y}
+}
+
+func (v Vec2) Scale(k int) Vec2 {
+	return Vec2{v.X * k, v.Y * k}
+}
+
+// Step moves v one unit in direction d.
+func (v Vec2) Step(d Dir) Vec2 {
+	return v.Add(d.Vec())
+}
+
+// Manhattan returns the taxicab distance between v and w.
+func (v Vec2) Manhattan(w Vec2) int {
+	return helper.Abs(v.X-w.X) + helper.Abs(v.Y-w.Y)
+}
+
+// Wrap maps v onto a width by height torus, so stepping off one edge comes
+// back on the opposite one.
+func (v Vec2) Wrap(width, height int) Vec2 {
+	return Vec2{helper.Mod(v.X, width), helper.Mod(v.Y, height)}
+}
+
+// Dir is one of the four orthogonal directions, in clockwise order.
+type Dir int
+
+const (
+	Up Dir = iota
+	Right
+	Down
+	Left
+)
+
+// Dirs lists the directions clockwise from Up.
+var Dirs = [4]Dir{Up, Right, Down, Left}
+
+var dirVecs = [4]Vec2{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+
+func (d Dir) Vec() Vec2 {
+	return dirVecs[d]
+}
+
+func (d Dir) TurnRight() Dir {
+	return (d + 1) % 4
+}
+
+func (d Dir) TurnLeft() Dir {
+	return (d + 3) % 4
+}
+
+func (d Dir) Opposite() Dir {
+	return (d + 2) % 4
+}
+
+func (d Dir) String() string {
+	if d < Up || d > Left {
+		return fmt.Sprintf("Dir(%d)", int(d))
+	}
+	return [...]string{"up", "right", "down", "left"}[d]
+}
+
+// ParseArrow reads one of the arrows ^, >, v and <.
+func ParseArrow(r rune) (Dir, bool) {
+	switch r {
+	case '^':
+		return Up, true
+	case '>':
+		return Right, true
+	case 'v':
+		return Down, true
+	case '<':
+		return Left, true
+	}
+	return 0, false
+}
+
+// ParseCompass reads one of the compass letters N, E, S and W, with north
+// being up.
+func ParseCompass(r rune) (Dir, bool) {
+	switch r {
+	case 'N':
+		return Up, true
+	case 'E':
+		return Right, true
+	case 'S':
+		return Down, true
+	case 'W':
+		return Left, true
+	}
+	return 0, false
+}
@@ -0,0 +1,55 @@
+package geom
+
+import "testing"
+
+func TestVec2(t *testing.T) {
+	v, w := Vec2{X: 3, Y: -2}, Vec2{X: -1, Y: 4}
+	if got := v.Add(w); got != (Vec2{X: 2, Y: 2}) {
+		t.Errorf("Add = %v", got)
+	}
+	if got := v.Sub(w); got != (Vec2{X: 4, Y: -6}) {
+		t.Errorf("Sub = %v", got)
+	}
+	if got := v.Scale(-2); got != (Vec2{X: -6, Y: 4}) {
+		t.Errorf("Scale = %v", got)
+	}
+	if got := v.Manhattan(w); got != 10 {
+		t.Errorf("Manhattan = %d, want 10", got)
+	}
+	if got := (Vec2{X: -1, Y: 12}).Wrap(11, 7); got != (Vec2{X: 10, Y: 5}) {
+		t.Errorf("Wrap = %v, want {10 5}", got)
+	}
+}
+
+func TestDir(t *testing.T) {
+	for _, d := range Dirs {
+		if d.TurnRight().TurnLeft() != d {
+			t.Errorf("%v: turning right then left doesn't come back", d)
+		}
+		if d.Opposite() != d.TurnRight().TurnRight() {
+			t.Errorf("%v: opposite isn't two right turns", d)
+		}
+		if d.Vec().Add(d.Opposite().Vec()) != (Vec2{}) {
+			t.Errorf("%v: opposite vectors don't cancel", d)
+		}
+	}
+	if Left.TurnRight() != Up {
+		t.Errorf("Left.TurnRight() = %v, want up", Left.TurnRight())
+	}
+}
+
+func TestParse(t *testing.T) {
+	for i, r := range "^>v<" {
+		if d, ok := ParseArrow(r); !ok || d != Dirs[i] {
+			t.Errorf("ParseArrow(%q) = %v, %v", r, d, ok)
+		}
+	}
+	for i, r := range "NESW" {
+		if d, ok := ParseCompass(r); !ok || d != Dirs[i] {
+			t.Errorf("ParseCompass(%q) = %v, %v", r, d, ok)
+		}
+	}
+	if _, ok := ParseArrow('x'); ok {
+		t.Error("ParseArrow('x') succeeded")
+	}
+}
@@ -0,0 +1,159 @@
+// Package graph traverses unweighted implicit graphs, given as a function
+// from a node to its neighbors. Weighted searches live in package dijkstra.
+package graph
+
+import (
+	"iter"
+	"slices"
+)
+
+// Neighbors yields the nodes reachable from a node in one step. The grid
+// methods Neighbors4 and Neighbors8 fit it, usually wrapped in a filter.
+type Neighbors[S comparable] func(S) iter.Seq[S]
+
+// Filter keeps the steps of next for which keep holds.
+func Filter[S comparable](next Neighbors[S], keep func(from, to S) bool) Neighbors[S] {
+	return func(from S) iter.Seq[S] {
+		return func(yield func(S) bool) {
+			for to := range next(from) {
+				if keep(from, to) && !yield(to) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Traversal is what a BFS or DFS found: every reached node with its depth
+// and the node it was reached from.
+type Traversal[S comparable] struct {
+	// Dist is the number of steps from the nearest start for a BFS, and the
+	// depth in the search tree for a DFS.
+	Dist map[S]int
+	// Parent is the node each node was first reached from. Starts have none.
+	Parent map[S]S
+	// Order lists the reached nodes in the order they were visited.
+	Order []S
+}
+
+func newTraversal[S comparable]() *Traversal[S] {
+	return &Traversal[S]{Dist: make(map[S]int), Parent: make(map[S]S)}
+}
+
+// Reached reports whether s was visited.
+func (t *Traversal[S]) Reached(s S) bool {
+	_, ok := t.Dist[s]
+	return ok
+}
+
+// Path returns the nodes from a start to s following the parents, or nil
+// when s was not reached. For a BFS it is a shortest path.
+func (t *Traversal[S]) Path(s S) []S {
+	if !t.Reached(s) {
+		return nil
+	}
+	path := []S{s}
+	for p, ok := t.Parent[s]; ok; p, ok = t.Parent[p] {
+		path = append(path, p)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// BFS visits every node reachable from starts, nearest first.
+func BFS[S comparable](starts []S, next Neighbors[S]) *Traversal[S] {
+	t := newTraversal[S]()
+	for _, s := range starts {
+		if !t.Reached(s) {
+			t.Dist[s] = 0
+			t.Order = append(t.Order, s)
+		}
+	}
+	for i := 0; i < len(t.Order); i++ {
+		curr := t.Order[i]
+		for n := range next(curr) {
+			if t.Reached(n) {
+				continue
+			}
+			t.Dist[n] = t.Dist[curr] + 1
+			t.Parent[n] = curr
+			t.Order = append(t.Order, n)
+		}
+	}
+	return t
+}
+
+// DFS visits every node reachable from starts, going as deep as possible
+// before backtracking. Nodes are visited in preorder, the neighbors of a node
+// in the order next yields them.
+func DFS[S comparable](starts []S, next Neighbors[S]) *Traversal[S] {
+	t := newTraversal[S]()
+	type frame struct {
+		node   S
+		parent S
+		depth  int
+		root   bool
+	}
+	var stack []frame
+	for _, s := range slices.Backward(starts) {
+		stack = append(stack, frame{node: s, root: true})
+	}
+	for len(stack) > 0 {
+		f := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if t.Reached(f.node) {
+			continue
+		}
+		t.Dist[f.node] = f.depth
+		if !f.root {
+			t.Parent[f.node] = f.parent
+		}
+		t.Order = append(t.Order, f.node)
+
+		// Pushed in reverse so the first neighbor is visited first.
+		children := slices.Collect(next(f.node))
+		for _, n := range slices.Backward(children) {
+			if !t.Reached(n) {
+				stack = append(stack, frame{node: n, parent: f.node, depth: f.depth + 1})
+			}
+		}
+	}
+	return t
+}
+
+// FloodFill returns the set of nodes reachable from start, start included.
+func FloodFill[S comparable](start S, next Neighbors[S]) map[S]bool {
+	reached := make(map[S]bool)
+	for _, s := range BFS([]S{start}, next).Order {
+		reached[s] = true
+	}
+	return reached
+}
+
+// CountPaths returns how many distinct paths lead from any of starts to a
+// node for which isEnd holds. A path stops at the first end it meets. The
+// graph reachable from starts must be acyclic.
+func CountPaths[S comparable](starts []S, next Neighbors[S], isEnd func(S) bool) int {
+	memo := make(map[S]int)
+	var count func(S) int
+	count = func(s S) int {
+		if isEnd(s) {
+			return 1
+		}
+		if n, ok := memo[s]; ok {
+			return n
+		}
+		total := 0
+		for n := range next(s) {
+			total += count(n)
+		}
+		memo[s] = total
+		return total
+	}
+
+	total := 0
+	for _, s := range starts {
+		total += count(s)
+	}
+	return total
+}
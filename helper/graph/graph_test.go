@@ -0,0 +1,64 @@
+package graph
+
+import (
+	"iter"
+	"slices"
+	"testing"
+)
+
+// edges is a small DAG with two routes from a to d and a node, x, that a
+// cannot reach.
+var edges = map[string][]string{
+	"a": {"b", "c"},
+	"b": {"d"},
+	"c": {"d", "e"},
+	"x": {"a"},
+}
+
+func next(s string) iter.Seq[string] {
+	return slices.Values(edges[s])
+}
+
+func TestBFS(t *testing.T) {
+	tr := BFS([]string{"a"}, next)
+	if want := []string{"a", "b", "c", "d", "e"}; !slices.Equal(tr.Order, want) {
+		t.Errorf("Order = %v, want %v", tr.Order, want)
+	}
+	if tr.Dist["e"] != 2 {
+		t.Errorf("Dist[e] = %d, want 2", tr.Dist["e"])
+	}
+	if path := tr.Path("d"); !slices.Equal(path, []string{"a", "b", "d"}) {
+		t.Errorf("Path(d) = %v, want [a b d]", path)
+	}
+	if tr.Reached("x") || tr.Path("x") != nil {
+		t.Error("x was reached")
+	}
+}
+
+func TestDFS(t *testing.T) {
+	tr := DFS([]string{"a"}, next)
+	if want := []string{"a", "b", "d", "c", "e"}; !slices.Equal(tr.Order, want) {
+		t.Errorf("Order = %v, want %v", tr.Order, want)
+	}
+	if path := tr.Path("e"); !slices.Equal(path, []string{"a", "c", "e"}) {
+		t.Errorf("Path(e) = %v, want [a c e]", path)
+	}
+}
+
+func TestFloodFillAndFilter(t *testing.T) {
+	notC := Filter(next, func(_, to string) bool { return to != "c" })
+	reached := FloodFill("a", notC)
+	if len(reached) != 3 || !reached["a"] || !reached["b"] || !reached["d"] {
+		t.Errorf("FloodFill = %v, want a, b and d", reached)
+	}
+}
+
+func TestCountPaths(t *testing.T) {
+	isLeaf := func(s string) bool { return len(edges[s]) == 0 }
+	if n := CountPaths([]string{"a"}, next, isLeaf); n != 3 {
+		t.Errorf("CountPaths(a) = %d, want 3", n)
+	}
+	if n := CountPaths([]string{"a", "x"}, next, isLeaf); n != 6 {
+		t.Errorf("CountPaths(a, x) = %d, want 6", n)
+	}
+}
@@ -0,0 +1,145 @@
+// Package grid provides the rectangular grid most puzzles are played on.
+package grid
+
+import (
+	"iter"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aoc2024/helper/geom"
+)
+
+// Point is a position on a grid, X being the column and Y the row.
+type Point = geom.Vec2
+
+var (
+	// Dirs4 are the orthogonal steps: up, right, down, left.
+	Dirs4 = []Point{geom.Up.Vec(), geom.Right.Vec(), geom.Down.Vec(), geom.Left.Vec()}
+	// Dirs8 are the orthogonal and diagonal steps, clockwise from up.
+	Dirs8 = []Point{{X: 0, Y: -1}, {X: 1, Y: -1}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}, {X: -1, Y: 1}, {X: -1, Y: 0}, {X: -1, Y: -1}}
+)
+
+// Grid is a Width by Height grid of cells stored row by row.
+type Grid[T any] struct {
+	Width, Height int
+	cells         []T
+}
+
+func New[T any](width, height int) *Grid[T] {
+	return &Grid[T]{Width: width, Height: height, cells: make([]T, width*height)}
+}
+
+// Parse builds a grid with one row per line and one cell per rune, converted
+// by f. The grid is as wide as the longest line; cells missing from shorter
+// lines hold the zero value.
+func Parse[T any](lines []string, f func(r rune) T) *Grid[T] {
+	width := 0
+	for _, line := range lines {
+		width = max(width, utf8.RuneCountInString(line))
+	}
+	g := New[T](width, len(lines))
+	for y, line := range lines {
+		x := 0
+		for _, r := range line {
+			g.cells[y*width+x] = f(r)
+			x++
+		}
+	}
+	return g
+}
+
+// FromLines builds a grid holding the runes of lines.
+func FromLines(lines []string) *Grid[rune] {
+	return Parse(lines, func(r rune) rune { return r })
+}
+
+func (g *Grid[T]) InBounds(p Point) bool {
+	return p.X >= 0 && p.X < g.Width && p.Y >= 0 && p.Y < g.Height
+}
+
+// At returns the cell at p, which must be in bounds.
+func (g *Grid[T]) At(p Point) T {
+	return g.cells[p.Y*g.Width+p.X]
+}
+
+// Get returns the cell at p and whether p is in bounds.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.InBounds(p) {
+		var zero T
+		return zero, false
+	}
+	return g.At(p), true
+}
+
+func (g *Grid[T]) Set(p Point, v T) {
+	g.cells[p.Y*g.Width+p.X] = v
+}
+
+// All yields every cell with its position, row by row.
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(Point{X: i % g.Width, Y: i / g.Width}, v) {
+				return
+			}
+		}
+	}
+}
+
+func (g *Grid[T]) neighbors(p Point, dirs []Point) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, d := range dirs {
+			if next := p.Add(d); g.InBounds(next) && !yield(next) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors4 yields the orthogonal neighbors of p that are in bounds.
+func (g *Grid[T]) Neighbors4(p Point) iter.Seq[Point] {
+	return g.neighbors(p, Dirs4)
+}
+
+// Neighbors8 yields the orthogonal and diagonal neighbors of p that are in
+// bounds.
+func (g *Grid[T]) Neighbors8(p Point) iter.Seq[Point] {
+	return g.neighbors(p, Dirs8)
+}
+
+func (g *Grid[T]) Clone() *Grid[T] {
+	return &Grid[T]{Width: g.Width, Height: g.Height, cells: append([]T(nil), g.cells...)}
+}
+
+// Render draws the grid one line per row, using f to draw each cell.
+func (g *Grid[T]) Render(f func(T) rune) string {
+	var sb strings.Builder
+	for i, v := range g.cells {
+		sb.WriteRune(f(v))
+		if (i+1)%g.Width == 0 {
+			sb.WriteByte('\n')
+		}
+	}
+	return sb.String()
+}
+
+// Find returns the first position, row by row, that holds v.
+func Find[T comparable](g *Grid[T], v T) (Point, bool) {
+	for p, cell := range g.All() {
+		if cell == v {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+// FindAll returns every position that holds v, row by row.
+func FindAll[T comparable](g *Grid[T], v T) []Point {
+	var points []Point
+	for p, cell := range g.All() {
+		if cell == v {
+			points = append(points, p)
+		}
+	}
+	return points
+}
@@ -0,0 +1,52 @@
+package grid
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseAndRender(t *testing.T) {
+	g := FromLines([]string{"ab", "c"})
+	if g.Width != 2 || g.Height != 2 {
+		t.Fatalf("size = %dx%d, want 2x2", g.Width, g.Height)
+	}
+	if got := g.At(Point{X: 1, Y: 0}); got != 'b' {
+		t.Errorf("At(1,0) = %c, want b", got)
+	}
+	render := g.Render(func(r rune) rune {
+		if r == 0 {
+			return '.'
+		}
+		return r
+	})
+	if want := "ab\nc.\n"; render != want {
+		t.Errorf("Render = %q, want %q", render, want)
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	g := New[int](3, 3)
+	corner := slices.Collect(g.Neighbors4(Point{X: 0, Y: 0}))
+	if want := []Point{{X: 1, Y: 0}, {X: 0, Y: 1}}; !slices.Equal(corner, want) {
+		t.Errorf("Neighbors4(corner) = %v, want %v", corner, want)
+	}
+	if n := len(slices.Collect(g.Neighbors8(Point{X: 1, Y: 1}))); n != 8 {
+		t.Errorf("Neighbors8(center) has %d points, want 8", n)
+	}
+}
+
+func TestFindAndClone(t *testing.T) {
+	g := FromLines([]string{"#.#", "..#"})
+	if p, ok := Find(g, '.'); !ok || p != (Point{X: 1, Y: 0}) {
+		t.Errorf("Find = %v, %v, want {1 0}, true", p, ok)
+	}
+	if got := FindAll(g, '#'); !slices.Equal(got, []Point{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 1}}) {
+		t.Errorf("FindAll = %v", got)
+	}
+
+	c := g.Clone()
+	c.Set(Point{X: 0, Y: 0}, '.')
+	if g.At(Point{X: 0, Y: 0}) != '#' {
+		t.Error("changing a clone changed the original")
+	}
+}
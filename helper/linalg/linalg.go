@@ -0,0 +1,115 @@
+// Package linalg solves systems of linear equations exactly, over rational
+// numbers, so puzzles asking for whole-number solutions can tell them apart
+// from nearly-whole floating-point ones.
+package linalg
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrSingular means the system has no unique solution. Solve returns
+	// one of the two errors below, which both match it with errors.Is.
+	ErrSingular = errors.New("linalg: singular system")
+	// ErrInconsistent means no assignment satisfies every equation.
+	ErrInconsistent = fmt.Errorf("%w: no solution", ErrSingular)
+	// ErrUnderdetermined means infinitely many assignments do.
+	ErrUnderdetermined = fmt.Errorf("%w: infinitely many solutions", ErrSingular)
+)
+
+// Solve returns the unique x such that a·x = b, by Gaussian elimination.
+// a has one row per equation and one column per unknown; it may have more
+// equations than unknowns as long as they agree. a and b are not modified.
+func Solve(a [][]Rat, b []Rat) ([]Rat, error) {
+	if len(a) != len(b) {
+		return nil, fmt.Errorf("linalg: %d equations but %d right-hand sides", len(a), len(b))
+	}
+	if len(a) == 0 {
+		return nil, ErrUnderdetermined
+	}
+	n := len(a[0])
+
+	// m is the augmented matrix [a | b].
+	m := make([][]Rat, len(a))
+	for i, row := range a {
+		if len(row) != n {
+			return nil, fmt.Errorf("linalg: equation %d has %d coefficients, want %d", i+1, len(row), n)
+		}
+		m[i] = append(append(make([]Rat, 0, n+1), row...), b[i])
+	}
+
+	// Reduce to reduced row echelon form. Any nonzero pivot will do since
+	// the arithmetic is exact.
+	rank := 0
+	for col := 0; col < n && rank < len(m); col++ {
+		pivot := rank
+		for pivot < len(m) && m[pivot][col].Sign() == 0 {
+			pivot++
+		}
+		if pivot == len(m) {
+			continue
+		}
+		m[rank], m[pivot] = m[pivot], m[rank]
+
+		lead := m[rank][col]
+		for j := col; j <= n; j++ {
+			m[rank][j] = m[rank][j].Quo(lead)
+		}
+		for i := range m {
+			if i == rank || m[i][col].Sign() == 0 {
+				continue
+			}
+			f := m[i][col]
+			for j := col; j <= n; j++ {
+				m[i][j] = m[i][j].Sub(f.Mul(m[rank][j]))
+			}
+		}
+		rank++
+	}
+
+	// Rows past the rank have all-zero coefficients left.
+	for _, row := range m[rank:] {
+		if row[n].Sign() != 0 {
+			return nil, ErrInconsistent
+		}
+	}
+	if rank < n {
+		return nil, ErrUnderdetermined
+	}
+	x := make([]Rat, n)
+	for i := range x {
+		x[i] = m[i][n]
+	}
+	return x, nil
+}
+
+// SolveInt64 is Solve for integer coefficients.
+func SolveInt64(a [][]int64, b []int64) ([]Rat, error) {
+	ra := make([][]Rat, len(a))
+	for i, row := range a {
+		ra[i] = make([]Rat, len(row))
+		for j, v := range row {
+			ra[i][j] = Int(v)
+		}
+	}
+	rb := make([]Rat, len(b))
+	for i, v := range b {
+		rb[i] = Int(v)
+	}
+	return Solve(ra, rb)
+}
+
+// Ints returns x as integers when every element is an integer that fits an
+// int64.
+func Ints(x []Rat) ([]int64, bool) {
+	ints := make([]int64, len(x))
+	for i, r := range x {
+		v, ok := r.Int64()
+		if !ok {
+			return nil, false
+		}
+		ints[i] = v
+	}
+	return ints, true
+}
@@ -0,0 +1,74 @@
+package linalg
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestRatFastPathAndOverflow(t *testing.T) {
+	if got := NewRat(6, -4).String(); got != "-3/2" {
+		t.Errorf("NewRat(6, -4) = %s, want -3/2", got)
+	}
+	var zero Rat
+	if got := zero.Add(Int(2)).Quo(Int(4)).String(); got != "1/2" {
+		t.Errorf("(0 + 2) / 4 = %s, want 1/2", got)
+	}
+
+	// MaxInt64 * 4 does not fit, but dividing it back by 8 does.
+	huge := Int(math.MaxInt64).Mul(Int(4))
+	if want := new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(4)); huge.Big().Num().Cmp(want) != 0 {
+		t.Errorf("MaxInt64 * 4 = %s, want %s", huge, want)
+	}
+	if _, ok := huge.Int64(); ok {
+		t.Error("MaxInt64 * 4 fits an int64")
+	}
+	back := huge.Quo(Int(8))
+	if got := back.String(); got != "9223372036854775807/2" {
+		t.Errorf("MaxInt64 * 4 / 8 = %s", got)
+	}
+	if back.big != nil {
+		t.Error("MaxInt64 / 2 was not moved back to the fast path")
+	}
+	if v, ok := Int(math.MinInt64).Neg().Neg().Int64(); !ok || v != math.MinInt64 {
+		t.Errorf("-(-MinInt64) = %d, %v", v, ok)
+	}
+	if NewRat(1, 3).Cmp(NewRat(1, 2)) != -1 {
+		t.Error("1/3 is not less than 1/2")
+	}
+}
+
+func TestSolve(t *testing.T) {
+	// Day 13's first example machine, with its prize moved far away.
+	const offset = 10000000000000
+	x, err := SolveInt64([][]int64{{94, 22}, {34, 67}}, []int64{8400, 5400})
+	if ints, ok := Ints(x); err != nil || !ok || ints[0] != 80 || ints[1] != 40 {
+		t.Errorf("Solve = %v, %v, want [80 40]", x, err)
+	}
+	x, err = SolveInt64([][]int64{{94, 22}, {34, 67}}, []int64{8400 + offset, 5400 + offset})
+	if _, ok := Ints(x); err != nil || ok {
+		t.Errorf("Solve with offset = %v, %v, want a fractional solution", x, err)
+	}
+
+	x, err = SolveInt64([][]int64{{0, 2, 1}, {1, 1, 1}, {2, 1, 0}}, []int64{7, 6, 4})
+	if ints, ok := Ints(x); err != nil || !ok || ints[0] != 1 || ints[1] != 2 || ints[2] != 3 {
+		t.Errorf("Solve 3x3 = %v, %v, want [1 2 3]", x, err)
+	}
+}
+
+func TestSolveSingular(t *testing.T) {
+	_, err := SolveInt64([][]int64{{1, 2}, {2, 4}}, []int64{3, 7})
+	if !errors.Is(err, ErrInconsistent) || !errors.Is(err, ErrSingular) {
+		t.Errorf("inconsistent system error = %v", err)
+	}
+	_, err = SolveInt64([][]int64{{1, 2}, {2, 4}}, []int64{3, 6})
+	if !errors.Is(err, ErrUnderdetermined) || !errors.Is(err, ErrSingular) {
+		t.Errorf("underdetermined system error = %v", err)
+	}
+	// More equations than unknowns is fine when they agree.
+	x, err := SolveInt64([][]int64{{1}, {2}}, []int64{3, 6})
+	if err != nil || x[0].String() != "3" {
+		t.Errorf("overdetermined system = %v, %v, want [3]", x, err)
+	}
+}
@@ -0,0 +1,199 @@
+package linalg
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/aoc2024/helper"
+)
+
+// Rat is an exact rational number. It is kept as a pair of int64 while that
+// is enough and switches to a big.Rat when a result would overflow, so
+// callers never have to care. The zero value is 0.
+type Rat struct {
+	num, den int64 // used when big is nil; den is positive unless zero-valued
+	big      *big.Rat
+}
+
+// NewRat returns num/den. It panics when den is 0.
+func NewRat(num, den int64) Rat {
+	if den == 0 {
+		panic("linalg: zero denominator")
+	}
+	if r, ok := small(num, den); ok {
+		return r
+	}
+	return FromBig(big.NewRat(num, den))
+}
+
+// Int returns n as a Rat.
+func Int(n int64) Rat {
+	return Rat{num: n, den: 1}
+}
+
+// FromBig returns a copy of x as a Rat.
+func FromBig(x *big.Rat) Rat {
+	if x.Num().IsInt64() && x.Denom().IsInt64() {
+		if r, ok := small(x.Num().Int64(), x.Denom().Int64()); ok {
+			return r
+		}
+	}
+	return Rat{big: new(big.Rat).Set(x)}
+}
+
+// small reduces num/den to lowest terms with a positive denominator. It
+// fails when that needs negating math.MinInt64.
+func small(num, den int64) (Rat, bool) {
+	if num == math.MinInt64 || den == math.MinInt64 {
+		return Rat{}, false
+	}
+	if den < 0 {
+		num, den = -num, -den
+	}
+	g := helper.Gcd(num, den)
+	return Rat{num: num / g, den: den / g}, true
+}
+
+func (r Rat) parts() (num, den int64) {
+	if r.den == 0 {
+		return 0, 1
+	}
+	return r.num, r.den
+}
+
+// Big returns r as a new big.Rat.
+func (r Rat) Big() *big.Rat {
+	if r.big != nil {
+		return new(big.Rat).Set(r.big)
+	}
+	num, den := r.parts()
+	return big.NewRat(num, den)
+}
+
+func (r Rat) Add(s Rat) Rat {
+	if r.big == nil && s.big == nil {
+		rn, rd := r.parts()
+		sn, sd := s.parts()
+		a, ok1 := mul(rn, sd)
+		b, ok2 := mul(sn, rd)
+		num, ok3 := add(a, b)
+		den, ok4 := mul(rd, sd)
+		if ok1 && ok2 && ok3 && ok4 {
+			if q, ok := small(num, den); ok {
+				return q
+			}
+		}
+	}
+	return FromBig(new(big.Rat).Add(r.Big(), s.Big()))
+}
+
+func (r Rat) Neg() Rat {
+	if r.big == nil && r.num != math.MinInt64 {
+		return Rat{num: -r.num, den: r.den}
+	}
+	return FromBig(new(big.Rat).Neg(r.Big()))
+}
+
+func (r Rat) Sub(s Rat) Rat {
+	return r.Add(s.Neg())
+}
+
+func (r Rat) Mul(s Rat) Rat {
+	if r.big == nil && s.big == nil {
+		rn, rd := r.parts()
+		sn, sd := s.parts()
+		num, ok1 := mul(rn, sn)
+		den, ok2 := mul(rd, sd)
+		if ok1 && ok2 {
+			if q, ok := small(num, den); ok {
+				return q
+			}
+		}
+	}
+	return FromBig(new(big.Rat).Mul(r.Big(), s.Big()))
+}
+
+// Quo returns r/s. It panics when s is 0.
+func (r Rat) Quo(s Rat) Rat {
+	if s.Sign() == 0 {
+		panic("linalg: division by zero")
+	}
+	if r.big == nil && s.big == nil {
+		rn, rd := r.parts()
+		sn, sd := s.parts()
+		num, ok1 := mul(rn, sd)
+		den, ok2 := mul(rd, sn)
+		if ok1 && ok2 {
+			if q, ok := small(num, den); ok {
+				return q
+			}
+		}
+	}
+	return FromBig(new(big.Rat).Quo(r.Big(), s.Big()))
+}
+
+// Sign returns -1, 0 or 1 as r is negative, zero or positive.
+func (r Rat) Sign() int {
+	if r.big != nil {
+		return r.big.Sign()
+	}
+	switch {
+	case r.num < 0:
+		return -1
+	case r.num > 0:
+		return 1
+	}
+	return 0
+}
+
+// Cmp returns -1, 0 or 1 as r is less than, equal to or greater than s.
+func (r Rat) Cmp(s Rat) int {
+	return r.Sub(s).Sign()
+}
+
+// IsInt reports whether r is an integer.
+func (r Rat) IsInt() bool {
+	if r.big != nil {
+		return r.big.IsInt()
+	}
+	_, den := r.parts()
+	return den == 1
+}
+
+// Int64 returns r when it is an integer that fits an int64.
+func (r Rat) Int64() (int64, bool) {
+	if !r.IsInt() {
+		return 0, false
+	}
+	if r.big != nil {
+		return r.big.Num().Int64(), r.big.Num().IsInt64()
+	}
+	return r.num, true
+}
+
+func (r Rat) String() string {
+	if r.big != nil {
+		return r.big.RatString()
+	}
+	return r.Big().RatString()
+}
+
+// mul and add report false when the result overflows an int64.
+func mul(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	if c/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return c, true
+}
+
+func add(a, b int64) (int64, bool) {
+	c := a + b
+	if (a > 0 && b > 0 && c < 0) || (a < 0 && b < 0 && c >= 0) {
+		return 0, false
+	}
+	return c, true
+}
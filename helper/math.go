@@ -1,33 +1,45 @@
 package helper
 
-func Abs(x int) int {
+// Signed is satisfied by the signed integer types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is satisfied by the unsigned integer types.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is satisfied by every integer type.
+type Integer interface {
+	Signed | Unsigned
+}
+
+func Abs[T Signed](x T) T {
 	if x < 0 {
 		return -x
 	}
 	return x
 }
 
-func Gcd(a, b int) int {
+// Gcd returns the greatest common divisor of a and b, which is never
+// negative.
+func Gcd[T Integer](a, b T) T {
 	for b != 0 {
 		a, b = b, a%b
 	}
-	return Abs(a)
-}
-
-func Gcd64(a, b int64) int64 {
-	for b != 0 {
-		a, b = b, a%b
+	if a < 0 {
+		return -a
 	}
-	return Abs64(a)
+	return a
 }
 
-func Abs64(x int64) int64 {
-	if x < 0 {
-		return -x
+// Mod returns a modulo b in [0, b), unlike % which keeps the sign of a. b
+// must be positive.
+func Mod[T Integer](a, b T) T {
+	r := a % b
+	if r < 0 {
+		r += b
 	}
-	return x
-}
-
-func Mod(a, b int) int {
-	return ((a % b) + b) % b
+	return r
 }
@@ -0,0 +1,150 @@
+// Package numtheory has the modular arithmetic behind the puzzles whose
+// states repeat: extended Euclid, modular inverses and powers, the Chinese
+// Remainder Theorem and least common multiples.
+//
+// Products are computed on 128 bits where it matters, so moduli may use the
+// whole range of their type.
+package numtheory
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/aoc2024/helper"
+)
+
+var (
+	// ErrOverflow means the result does not fit the integer type.
+	ErrOverflow = errors.New("numtheory: integer overflow")
+	// ErrNoInverse means the number shares a factor with the modulus.
+	ErrNoInverse = errors.New("numtheory: no modular inverse")
+	// ErrNoSolution means a system of congruences contradicts itself.
+	ErrNoSolution = errors.New("numtheory: congruences have no common solution")
+	// ErrModulus means a modulus was not positive.
+	ErrModulus = errors.New("numtheory: modulus must be positive")
+)
+
+// ExtGCD returns g = gcd(a, b) along with x and y such that a*x + b*y = g.
+func ExtGCD[T helper.Signed](a, b T) (g, x, y T) {
+	oldR, r := a, b
+	oldX, x := T(1), T(0)
+	oldY, y := T(0), T(1)
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// ModInverse returns the x in [0, m) such that a*x = 1 modulo m.
+func ModInverse[T helper.Signed](a, m T) (T, error) {
+	if m <= 0 {
+		return 0, ErrModulus
+	}
+	g, x, _ := ExtGCD(helper.Mod(a, m), m)
+	if g != 1 {
+		return 0, ErrNoInverse
+	}
+	return helper.Mod(x, m), nil
+}
+
+// MulMod returns a*b modulo m, in [0, m), without overflowing.
+func MulMod[T helper.Integer](a, b, m T) (T, error) {
+	if m <= 0 {
+		return 0, ErrModulus
+	}
+	return mulMod(a, b, m), nil
+}
+
+// mulMod is MulMod for callers that have checked m is positive.
+func mulMod[T helper.Integer](a, b, m T) T {
+	a, b = helper.Mod(a, m), helper.Mod(b, m)
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	_, rem := bits.Div64(hi%uint64(m), lo, uint64(m))
+	return T(rem)
+}
+
+// ModPow returns base raised to exp modulo m, in [0, m). exp must not be
+// negative.
+func ModPow[T helper.Integer](base, exp, m T) (T, error) {
+	if m <= 0 {
+		return 0, ErrModulus
+	}
+	if exp < 0 {
+		return 0, errors.New("numtheory: negative exponent")
+	}
+	result := helper.Mod(1, m)
+	base = helper.Mod(base, m)
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = mulMod(result, base, m)
+		}
+		base = mulMod(base, base, m)
+	}
+	return result, nil
+}
+
+// LCM returns the least common multiple of a and b, which is never
+// negative, or ErrOverflow when it does not fit T.
+func LCM[T helper.Integer](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	if a < 0 {
+		a = -a
+	}
+	if b < 0 {
+		b = -b
+	}
+	// -a is still negative for the smallest value of a signed type.
+	if a < 0 || b < 0 {
+		return 0, ErrOverflow
+	}
+	a /= helper.Gcd(a, b)
+	l := a * b
+	if l/b != a || l < 0 {
+		return 0, ErrOverflow
+	}
+	return l, nil
+}
+
+// CRT solves the system x = residues[i] modulo moduli[i]. The moduli need
+// not be coprime. It returns the smallest solution x in [0, m) and m, the
+// least common multiple of the moduli, so every solution is x plus a
+// multiple of m. It fails with ErrNoSolution when the congruences
+// contradict each other and ErrOverflow when m does not fit T.
+func CRT[T helper.Signed](residues, moduli []T) (x, m T, err error) {
+	if len(residues) != len(moduli) {
+		panic("numtheory: CRT needs as many residues as moduli")
+	}
+	x, m = 0, 1
+	for i, mi := range moduli {
+		if mi <= 0 {
+			return 0, 0, ErrModulus
+		}
+		ri := helper.Mod(residues[i], mi)
+
+		// x + m*k = ri (mod mi) has a solution k exactly when g divides
+		// ri - x, and then k is unique modulo mi/g.
+		g, inv, _ := ExtGCD(m, mi)
+		diff := ri - helper.Mod(x, mi)
+		if diff%g != 0 {
+			return 0, 0, ErrNoSolution
+		}
+		step := mi / g
+		k := mulMod(diff/g, helper.Mod(inv, step), step)
+
+		l, err := LCM(m, mi)
+		if err != nil {
+			return 0, 0, err
+		}
+		// x < m and k < mi/g, so x + m*k < l and nothing overflows.
+		x, m = x+m*k, l
+	}
+	return x, m, nil
+}
@@ -0,0 +1,92 @@
+package numtheory
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestExtGCD(t *testing.T) {
+	for _, tc := range [][2]int{{240, 46}, {-240, 46}, {17, 5}, {0, 7}, {7, 0}} {
+		a, b := tc[0], tc[1]
+		g, x, y := ExtGCD(a, b)
+		if g < 0 || a*x+b*y != g || (g != 0 && (a%g != 0 || b%g != 0)) {
+			t.Errorf("ExtGCD(%d, %d) = %d, %d, %d", a, b, g, x, y)
+		}
+	}
+}
+
+func TestMulMod(t *testing.T) {
+	const p = int64(9223372036854775783)
+	tests := []struct {
+		a, b, m, want int64
+	}{
+		{7, 8, 5, 1},
+		{-7, 8, 5, 4},
+		{p - 1, p - 1, p, 1},
+		{3, 4, 1, 0},
+	}
+	for _, tt := range tests {
+		if got, err := MulMod(tt.a, tt.b, tt.m); err != nil || got != tt.want {
+			t.Errorf("MulMod(%d, %d, %d) = %d, %v, want %d", tt.a, tt.b, tt.m, got, err, tt.want)
+		}
+	}
+	for _, m := range []int64{0, -5} {
+		if _, err := MulMod(int64(3), 4, m); !errors.Is(err, ErrModulus) {
+			t.Errorf("MulMod(3, 4, %d) error = %v, want ErrModulus", m, err)
+		}
+	}
+}
+
+func TestModInverseAndPow(t *testing.T) {
+	if inv, err := ModInverse(3, 11); err != nil || inv != 4 {
+		t.Errorf("ModInverse(3, 11) = %d, %v, want 4", inv, err)
+	}
+	if inv, err := ModInverse(-3, 11); err != nil || inv != 7 {
+		t.Errorf("ModInverse(-3, 11) = %d, %v, want 7", inv, err)
+	}
+	if _, err := ModInverse(6, 9); !errors.Is(err, ErrNoInverse) {
+		t.Errorf("ModInverse(6, 9) error = %v, want ErrNoInverse", err)
+	}
+	if p, err := ModPow(4, 13, 497); err != nil || p != 445 {
+		t.Errorf("ModPow(4, 13, 497) = %d, %v, want 445", p, err)
+	}
+	// Fermat: a^(p-1) = 1 for a prime p too large to multiply naively.
+	const p = int64(9223372036854775783)
+	if r, err := ModPow(2, p-1, p); err != nil || r != 1 {
+		t.Errorf("ModPow(2, p-1, p) = %d, %v, want 1", r, err)
+	}
+}
+
+func TestLCM(t *testing.T) {
+	if l, err := LCM(4, -6); err != nil || l != 12 {
+		t.Errorf("LCM(4, -6) = %d, %v, want 12", l, err)
+	}
+	if _, err := LCM[int64](math.MaxInt64, 2); !errors.Is(err, ErrOverflow) {
+		t.Errorf("LCM(MaxInt64, 2) error = %v, want ErrOverflow", err)
+	}
+	if _, err := LCM[int8](math.MinInt8, 3); !errors.Is(err, ErrOverflow) {
+		t.Errorf("LCM(MinInt8, 3) error = %v, want ErrOverflow", err)
+	}
+	if l, err := LCM[uint8](15, 17); err != nil || l != 255 {
+		t.Errorf("LCM[uint8](15, 17) = %d, %v, want 255", l, err)
+	}
+}
+
+func TestCRT(t *testing.T) {
+	x, m, err := CRT([]int{2, 3, 2}, []int{3, 5, 7})
+	if err != nil || x != 23 || m != 105 {
+		t.Errorf("CRT coprime = %d, %d, %v, want 23, 105", x, m, err)
+	}
+	// Not coprime, but consistent: x = 3 (mod 4) and x = 5 (mod 6).
+	x, m, err = CRT([]int{3, -1}, []int{4, 6})
+	if err != nil || x != 11 || m != 12 {
+		t.Errorf("CRT non-coprime = %d, %d, %v, want 11, 12", x, m, err)
+	}
+	if _, _, err := CRT([]int{0, 1}, []int{4, 6}); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("CRT inconsistent error = %v, want ErrNoSolution", err)
+	}
+	if _, _, err := CRT([]int64{0, 0}, []int64{math.MaxInt64, 2}); !errors.Is(err, ErrOverflow) {
+		t.Errorf("CRT overflow error = %v, want ErrOverflow", err)
+	}
+}
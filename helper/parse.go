@@ -0,0 +1,252 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseMode decides what happens to fields a Parser can't read.
+type ParseMode int
+
+const (
+	// Lenient reads malformed fields as zero, which is what the days have
+	// always done.
+	Lenient ParseMode = iota
+	// Strict makes malformed fields fail the day.
+	Strict
+)
+
+// Parser reads numbers out of input lines and keeps a diagnostic, with line
+// and column, for every field it could not read. Lines and columns count
+// from 1, and every method takes the position at which its text starts so
+// fields cut out of the middle of a line are still reported where they are.
+type Parser struct {
+	Mode        ParseMode
+	diagnostics []*ParseError
+}
+
+// NewParser returns a parser in the given mode, which days take from the
+// solver.Input they are run on.
+func NewParser(mode ParseMode) *Parser {
+	return &Parser{Mode: mode}
+}
+
+func (p *Parser) report(line, col int, err error) {
+	p.diagnostics = append(p.diagnostics, &ParseError{Line: line, Col: col, Err: err})
+}
+
+// Diagnostics returns everything the parser could not read, in both modes.
+func (p *Parser) Diagnostics() []*ParseError {
+	return p.diagnostics
+}
+
+// Err returns the diagnostics as a single error in strict mode. In lenient
+// mode it always returns nil.
+func (p *Parser) Err() error {
+	if p.Mode != Strict || len(p.diagnostics) == 0 {
+		return nil
+	}
+	errs := make([]error, len(p.diagnostics))
+	for i, d := range p.diagnostics {
+		errs[i] = d
+	}
+	return errors.Join(errs...)
+}
+
+// Int parses s, found at line and col, as a decimal integer.
+func (p *Parser) Int(line, col int, s string) int {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		p.report(line, col, fmt.Errorf("invalid integer %q", s))
+		return 0
+	}
+	return v
+}
+
+// Uint64 parses s, found at line and col, as an unsigned decimal integer.
+func (p *Parser) Uint64(line, col int, s string) uint64 {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		p.report(line, col, fmt.Errorf("invalid unsigned integer %q", s))
+		return 0
+	}
+	return v
+}
+
+// BigInt parses s, found at line and col, as a decimal integer of any size.
+func (p *Parser) BigInt(line, col int, s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		p.report(line, col, fmt.Errorf("invalid integer %q", s))
+		return new(big.Int)
+	}
+	return v
+}
+
+// Ints parses every field of s as an integer. Fields are separated by sep,
+// or by runs of white space when sep is empty.
+func (p *Parser) Ints(line, col int, s, sep string) []int {
+	var values []int
+	for _, f := range splitFields(s, sep) {
+		values = append(values, p.Int(line, col+f.offset, f.text))
+	}
+	return values
+}
+
+// BigInts is Ints for integers of any size.
+func (p *Parser) BigInts(line, col int, s, sep string) []*big.Int {
+	var values []*big.Int
+	for _, f := range splitFields(s, sep) {
+		values = append(values, p.BigInt(line, col+f.offset, f.text))
+	}
+	return values
+}
+
+// Uint64s is Ints for unsigned integers.
+func (p *Parser) Uint64s(line, col int, s, sep string) []uint64 {
+	var values []uint64
+	for _, f := range splitFields(s, sep) {
+		values = append(values, p.Uint64(line, col+f.offset, f.text))
+	}
+	return values
+}
+
+// Digits parses every character of s as a single decimal digit.
+func (p *Parser) Digits(line, col int, s string) []int {
+	digits := make([]int, 0, len(s))
+	for i, c := range s {
+		if c < '0' || c > '9' {
+			p.report(line, col+i, fmt.Errorf("invalid digit %q", c))
+			digits = append(digits, 0)
+			continue
+		}
+		digits = append(digits, int(c-'0'))
+	}
+	return digits
+}
+
+// Split cuts s into exactly n parts around sep, reporting s when it has a
+// different number of parts. The returned slice has n elements either way.
+func (p *Parser) Split(line, col int, s, sep string, n int) []string {
+	parts := strings.Split(s, sep)
+	if len(parts) != n {
+		p.report(line, col, fmt.Errorf("expected %d fields separated by %q in %q", n, sep, s))
+		parts = append(parts, make([]string, max(n-len(parts), 0))...)[:n]
+	}
+	return parts
+}
+
+// Scanf reads s, a whole line, against a template in the style of
+// fmt.Sscanf. The template supports %d (an optionally signed integer, read
+// into *int or *int64) and %s (a run of non-space characters, read into
+// *string). A space in the template matches one or more spaces in s, any
+// other character must match exactly. Scanf reports false when s does not
+// match, leaving the remaining arguments untouched.
+func (p *Parser) Scanf(line int, s, template string, args ...any) bool {
+	pos, arg := 0, 0
+	fail := func(format string, a ...any) bool {
+		p.report(line, pos+1, fmt.Errorf(format, a...))
+		return false
+	}
+
+	for i := 0; i < len(template); i++ {
+		c := template[i]
+		switch {
+		case c == '%' && i+1 < len(template):
+			i++
+			if arg >= len(args) {
+				panic("helper: Scanf template has more verbs than arguments")
+			}
+			start := pos
+			switch template[i] {
+			case 'd':
+				if pos < len(s) && (s[pos] == '-' || s[pos] == '+') {
+					pos++
+				}
+				for pos < len(s) && s[pos] >= '0' && s[pos] <= '9' {
+					pos++
+				}
+				v, err := strconv.ParseInt(s[start:pos], 10, 64)
+				if err != nil {
+					pos = start
+					return fail("expected integer in %q", s)
+				}
+				switch dst := args[arg].(type) {
+				case *int:
+					*dst = int(v)
+				case *int64:
+					*dst = v
+				default:
+					panic(fmt.Sprintf("helper: Scanf can't read %%d into %T", dst))
+				}
+			case 's':
+				for pos < len(s) && !unicode.IsSpace(rune(s[pos])) {
+					pos++
+				}
+				dst, ok := args[arg].(*string)
+				if !ok {
+					panic(fmt.Sprintf("helper: Scanf can't read %%s into %T", args[arg]))
+				}
+				*dst = s[start:pos]
+			default:
+				panic(fmt.Sprintf("helper: Scanf doesn't support %%%c", template[i]))
+			}
+			arg++
+		case c == ' ':
+			if pos >= len(s) || s[pos] != ' ' {
+				return fail("expected space in %q", s)
+			}
+			for pos < len(s) && s[pos] == ' ' {
+				pos++
+			}
+		default:
+			if pos >= len(s) || s[pos] != c {
+				return fail("expected %q in %q", c, s)
+			}
+			pos++
+		}
+	}
+	if pos != len(s) {
+		return fail("unexpected trailing text in %q", s)
+	}
+	return true
+}
+
+type field struct {
+	text   string
+	offset int
+}
+
+// splitFields is strings.Split, or strings.Fields when sep is empty, that
+// also remembers where each field starts.
+func splitFields(s, sep string) []field {
+	var fields []field
+	if sep == "" {
+		start := -1
+		for i, c := range s {
+			if unicode.IsSpace(c) {
+				if start >= 0 {
+					fields = append(fields, field{s[start:i], start})
+					start = -1
+				}
+			} else if start < 0 {
+				start = i
+			}
+		}
+		if start >= 0 {
+			fields = append(fields, field{s[start:], start})
+		}
+		return fields
+	}
+
+	offset := 0
+	for _, text := range strings.Split(s, sep) {
+		fields = append(fields, field{text, offset})
+		offset += len(text) + len(sep)
+	}
+	return fields
+}
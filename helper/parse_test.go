@@ -0,0 +1,51 @@
+package helper
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParserInts(t *testing.T) {
+	p := &Parser{Mode: Strict}
+	got := p.Ints(3, 5, "1  x 3", "")
+	if want := []int{1, 0, 3}; !slices.Equal(got, want) {
+		t.Errorf("Ints = %v, want %v", got, want)
+	}
+	diags := p.Diagnostics()
+	if len(diags) != 1 || diags[0].Line != 3 || diags[0].Col != 8 {
+		t.Fatalf("diagnostics = %v, want one at line 3, column 8", diags)
+	}
+
+	var parseErr *ParseError
+	if err := p.Err(); !errors.As(err, &parseErr) {
+		t.Errorf("Err = %v, want a *ParseError", err)
+	}
+}
+
+func TestParserLenient(t *testing.T) {
+	p := &Parser{Mode: Lenient}
+	p.Ints(1, 1, "1,y,3", ",")
+	if len(p.Diagnostics()) != 1 {
+		t.Errorf("got %d diagnostics, want 1", len(p.Diagnostics()))
+	}
+	if err := p.Err(); err != nil {
+		t.Errorf("Err = %v in lenient mode, want nil", err)
+	}
+}
+
+func TestParserScanf(t *testing.T) {
+	p := &Parser{Mode: Strict}
+	var a, b int64
+	if !p.Scanf(1, "Button A: X+94, Y+34", "Button A: X+%d, Y+%d", &a, &b) || a != 94 || b != 34 {
+		t.Errorf("Scanf = %d, %d, want 94, 34", a, b)
+	}
+
+	var x, y int
+	if p.Scanf(2, "p=1,z", "p=%d,%d", &x, &y) {
+		t.Errorf("Scanf matched malformed line")
+	}
+	if diags := p.Diagnostics(); len(diags) != 1 || diags[0].Col != 5 {
+		t.Errorf("diagnostics = %v, want one at column 5", diags)
+	}
+}
@@ -0,0 +1,21 @@
+// Package randtest makes the random cases that tests check a solution
+// against a slower reference with. The cases depend only on the seed, so a
+// failing one comes back on every run.
+package randtest
+
+import "math/rand/v2"
+
+// Rand returns a source of random numbers seeded with seed.
+func Rand(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// Cases returns n cases made by gen, drawing from Rand(seed).
+func Cases[T any](seed uint64, n int, gen func(r *rand.Rand) T) []T {
+	r := Rand(seed)
+	cases := make([]T, n)
+	for i := range cases {
+		cases[i] = gen(r)
+	}
+	return cases
+}
@@ -0,0 +1,18 @@
+package randtest
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestCasesRepeat(t *testing.T) {
+	gen := func(r *rand.Rand) int { return r.IntN(1000) }
+	a, b := Cases(1, 20, gen), Cases(1, 20, gen)
+	if !slices.Equal(a, b) {
+		t.Errorf("same seed gave %v and %v", a, b)
+	}
+	if c := Cases(2, 20, gen); slices.Equal(a, c) {
+		t.Errorf("seeds 1 and 2 both gave %v", a)
+	}
+}
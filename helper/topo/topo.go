@@ -0,0 +1,231 @@
+// Package topo orders the nodes of a directed graph so that every edge goes
+// forward, or finds a cycle that makes this impossible.
+//
+// Orders are deterministic: whenever several nodes could come next, the one
+// added to the graph first wins, so the result never depends on map
+// iteration order.
+package topo
+
+import (
+	"container/heap"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Graph is a directed graph whose edges say which node must come first.
+type Graph[N comparable] struct {
+	nodes []N
+	index map[N]int
+	succs [][]int
+	preds [][]int
+}
+
+func New[N comparable]() *Graph[N] {
+	return &Graph[N]{index: make(map[N]int)}
+}
+
+// AddNode adds n, unless the graph already has it.
+func (g *Graph[N]) AddNode(n N) {
+	g.node(n)
+}
+
+func (g *Graph[N]) node(n N) int {
+	if i, ok := g.index[n]; ok {
+		return i
+	}
+	g.index[n] = len(g.nodes)
+	g.nodes = append(g.nodes, n)
+	g.succs = append(g.succs, nil)
+	g.preds = append(g.preds, nil)
+	return len(g.nodes) - 1
+}
+
+// AddEdge requires before to come before after, adding either node if
+// needed.
+func (g *Graph[N]) AddEdge(before, after N) {
+	b, a := g.node(before), g.node(after)
+	g.succs[b] = append(g.succs[b], a)
+	g.preds[a] = append(g.preds[a], b)
+}
+
+// Has reports whether n is a node of g.
+func (g *Graph[N]) Has(n N) bool {
+	_, ok := g.index[n]
+	return ok
+}
+
+// Len returns the number of nodes.
+func (g *Graph[N]) Len() int {
+	return len(g.nodes)
+}
+
+// CycleError is returned when the graph has a cycle. Cycle lists its nodes
+// in edge order, without repeating the first one.
+type CycleError[N comparable] struct {
+	Cycle []N
+}
+
+func (e *CycleError[N]) Error() string {
+	parts := make([]string, 0, len(e.Cycle)+1)
+	for _, n := range e.Cycle {
+		parts = append(parts, fmt.Sprint(n))
+	}
+	if len(e.Cycle) > 0 {
+		parts = append(parts, fmt.Sprint(e.Cycle[0]))
+	}
+	return "cycle: " + strings.Join(parts, " -> ")
+}
+
+// indexHeap pops the smallest node index, that is the earliest added node.
+type indexHeap []int
+
+func (h indexHeap) Len() int           { return len(h) }
+func (h indexHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h indexHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *indexHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *indexHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// Kahn sorts the graph by repeatedly taking a node with no pending
+// predecessors, the earliest added one when there are several. It returns a
+// *CycleError when the graph has a cycle.
+func (g *Graph[N]) Kahn() ([]N, error) {
+	indegree := make([]int, len(g.nodes))
+	for i := range g.nodes {
+		indegree[i] = len(g.preds[i])
+	}
+	ready := &indexHeap{}
+	for i, d := range indegree {
+		if d == 0 {
+			heap.Push(ready, i)
+		}
+	}
+
+	order := make([]N, 0, len(g.nodes))
+	for ready.Len() > 0 {
+		i := heap.Pop(ready).(int)
+		order = append(order, g.nodes[i])
+		for _, s := range g.succs[i] {
+			if indegree[s]--; indegree[s] == 0 {
+				heap.Push(ready, s)
+			}
+		}
+	}
+	if len(order) < len(g.nodes) {
+		return nil, g.cycleAmong(indegree)
+	}
+	return order, nil
+}
+
+// cycleAmong finds a cycle among the nodes Kahn could not place. Each of
+// them still has an unplaced predecessor, so walking backwards from any of
+// them must eventually come back to a node already seen.
+func (g *Graph[N]) cycleAmong(indegree []int) error {
+	start := slices.IndexFunc(indegree, func(d int) bool { return d > 0 })
+	seenAt := make(map[int]int)
+	var walk []int
+	for i := start; ; {
+		if at, seen := seenAt[i]; seen {
+			walk = walk[at:]
+			break
+		}
+		seenAt[i] = len(walk)
+		walk = append(walk, i)
+		for _, p := range g.preds[i] {
+			if indegree[p] > 0 {
+				i = p
+				break
+			}
+		}
+	}
+	slices.Reverse(walk)
+	return g.cycleError(walk)
+}
+
+func (g *Graph[N]) cycleError(cycle []int) error {
+	err := &CycleError[N]{}
+	for _, i := range cycle {
+		err.Cycle = append(err.Cycle, g.nodes[i])
+	}
+	return err
+}
+
+// DFS sorts the graph by depth-first search, in reverse postorder. Roots are
+// tried in the order they were added and successors in the order their edges
+// were. It returns a *CycleError when the graph has a cycle.
+func (g *Graph[N]) DFS() ([]N, error) {
+	const (
+		unvisited = iota
+		active
+		done
+	)
+	state := make([]int, len(g.nodes))
+	post := make([]int, 0, len(g.nodes))
+	var path []int
+
+	var visit func(i int) error
+	visit = func(i int) error {
+		state[i] = active
+		path = append(path, i)
+		for _, s := range g.succs[i] {
+			switch state[s] {
+			case active:
+				return g.cycleError(path[slices.Index(path, s):])
+			case unvisited:
+				if err := visit(s); err != nil {
+					return err
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[i] = done
+		post = append(post, i)
+		return nil
+	}
+
+	for i := range g.nodes {
+		if state[i] == unvisited {
+			if err := visit(i); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	order := make([]N, len(post))
+	for k, i := range post {
+		order[len(post)-1-k] = g.nodes[i]
+	}
+	return order, nil
+}
+
+// IsLinearization reports whether seq holds every node of g exactly once
+// and puts the first node of every edge before the second.
+func (g *Graph[N]) IsLinearization(seq []N) bool {
+	if len(seq) != len(g.nodes) {
+		return false
+	}
+	pos := make([]int, len(g.nodes))
+	for i := range pos {
+		pos[i] = -1
+	}
+	for at, n := range seq {
+		i, ok := g.index[n]
+		if !ok || pos[i] >= 0 {
+			return false
+		}
+		pos[i] = at
+	}
+	for i, succs := range g.succs {
+		for _, s := range succs {
+			if pos[i] > pos[s] {
+				return false
+			}
+		}
+	}
+	return true
+}
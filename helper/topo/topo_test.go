@@ -0,0 +1,84 @@
+package topo
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func sorters[N comparable](g *Graph[N]) map[string]func() ([]N, error) {
+	return map[string]func() ([]N, error){"Kahn": g.Kahn, "DFS": g.DFS}
+}
+
+func TestSortIsStable(t *testing.T) {
+	g := New[string]()
+	for _, n := range []string{"d", "c", "b", "a"} {
+		g.AddNode(n)
+	}
+	g.AddEdge("a", "b")
+
+	order, err := g.Kahn()
+	if want := []string{"d", "c", "a", "b"}; err != nil || !slices.Equal(order, want) {
+		t.Errorf("Kahn = %v, %v, want %v", order, err, want)
+	}
+	for name, sort := range sorters(g) {
+		for range 10 {
+			order, err := sort()
+			if err != nil || !g.IsLinearization(order) {
+				t.Fatalf("%s = %v, %v, not a linearization", name, order, err)
+			}
+			again, _ := sort()
+			if !slices.Equal(order, again) {
+				t.Fatalf("%s is not deterministic: %v, then %v", name, order, again)
+			}
+		}
+	}
+}
+
+func TestCycle(t *testing.T) {
+	g := New[int]()
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	g.AddEdge(3, 4)
+
+	for name, sort := range sorters(g) {
+		_, err := sort()
+		var cycle *CycleError[int]
+		if !errors.As(err, &cycle) {
+			t.Fatalf("%s error = %v, want a CycleError", name, err)
+		}
+		if len(cycle.Cycle) != 3 || !slices.Contains(cycle.Cycle, 1) {
+			t.Errorf("%s cycle = %v, want 1, 2 and 3", name, cycle.Cycle)
+		}
+		for i, n := range cycle.Cycle {
+			next := cycle.Cycle[(i+1)%len(cycle.Cycle)]
+			if next != (n%3)+1 {
+				t.Errorf("%s cycle %v doesn't follow the edges", name, cycle.Cycle)
+				break
+			}
+		}
+	}
+}
+
+func TestIsLinearization(t *testing.T) {
+	g := New[int]()
+	g.AddEdge(1, 2)
+	g.AddNode(3)
+	for _, tc := range []struct {
+		seq  []int
+		want bool
+	}{
+		{[]int{1, 2, 3}, true},
+		{[]int{3, 1, 2}, true},
+		{[]int{2, 1, 3}, false},
+		{[]int{1, 2}, false},
+		{[]int{1, 1, 2}, false},
+		{[]int{1, 2, 4}, false},
+	} {
+		if got := g.IsLinearization(tc.seq); got != tc.want {
+			t.Errorf("IsLinearization(%v) = %v, want %v", tc.seq, got, tc.want)
+		}
+	}
+}
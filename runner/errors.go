@@ -0,0 +1,73 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aoc2024/helper"
+)
+
+// Exit statuses used by the commands, one per kind of failure.
+const (
+	ExitOK = iota
+	ExitSolve
+	ExitUsage
+	ExitInput
+	ExitParse
+)
+
+// UnknownDayError means a day was asked for that has no registered solver.
+type UnknownDayError struct {
+	Day int
+}
+
+func (e *UnknownDayError) Error() string {
+	return fmt.Sprintf("day %d has no registered solver", e.Day)
+}
+
+// InputError means the input of a day could not be read.
+type InputError struct {
+	Day int
+	Err error
+}
+
+func (e *InputError) Error() string {
+	return "reading input: " + e.Err.Error()
+}
+
+func (e *InputError) Unwrap() error {
+	return e.Err
+}
+
+// SolveError means a day's solver failed, either on malformed input (see
+// helper.ParseError) or on its own.
+type SolveError struct {
+	Day int
+	Err error
+}
+
+func (e *SolveError) Error() string {
+	return "solving: " + e.Err.Error()
+}
+
+func (e *SolveError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode maps an error returned by Run to the status a command exits with.
+func ExitCode(err error) int {
+	var unknownErr *UnknownDayError
+	var inputErr *InputError
+	var parseErr *helper.ParseError
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.As(err, &unknownErr):
+		return ExitUsage
+	case errors.As(err, &inputErr):
+		return ExitInput
+	case errors.As(err, &parseErr):
+		return ExitParse
+	}
+	return ExitSolve
+}
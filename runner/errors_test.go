@@ -0,0 +1,19 @@
+package runner
+
+import (
+	"io"
+	"testing"
+)
+
+// testDay is a day number no package registers.
+const testDay = 99
+
+func TestRunUnknownDay(t *testing.T) {
+	err := Run(io.Discard, testDay, Input{})
+	if _, ok := err.(*UnknownDayError); !ok {
+		t.Fatalf("got %v, want an UnknownDayError", err)
+	}
+	if code := ExitCode(err); code != ExitUsage {
+		t.Errorf("exit code %d, want %d", code, ExitUsage)
+	}
+}
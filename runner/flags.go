@@ -0,0 +1,39 @@
+package runner
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+)
+
+// dayFlags holds the functions defining each day's own flags.
+var dayFlags = make(map[int]func(fs *flag.FlagSet, prefix string) any)
+
+// RegisterFlags lets a day tune how it solves from the command line. define
+// is called with every flag set InputFlags sets up. It binds the day's
+// flags to a fresh options value, a pointer, and returns it; Run then hands
+// that value to the day's solver as solver.Input.Options. Flags must be
+// named prefix+name, prefix being "dayN.", so that a binary running every
+// day never sees two days claim the same flag. Like Register, it is meant
+// to be called from the day's init function.
+func RegisterFlags(day int, define func(fs *flag.FlagSet, prefix string) any) {
+	if _, exists := dayFlags[day]; exists {
+		panic(fmt.Sprintf("runner: flags of day %d registered twice", day))
+	}
+	dayFlags[day] = define
+}
+
+// defineDayFlags defines the flags of every day on fs and returns the
+// options they are bound to, by day.
+func defineDayFlags(fs *flag.FlagSet) map[int]any {
+	days := make([]int, 0, len(dayFlags))
+	for day := range dayFlags {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	options := make(map[int]any, len(days))
+	for _, day := range days {
+		options[day] = dayFlags[day](fs, fmt.Sprintf("day%d.", day))
+	}
+	return options
+}
@@ -0,0 +1,81 @@
+package runner
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/aoc2024/helper"
+)
+
+// Input selects what a day is run against. The zero value looks up the
+// day's input.txt, trying in order:
+//
+//  1. dayN/input.txt under the current directory, for runs from the
+//     repository root
+//  2. input.txt in the directory of the day's package, as recorded when the
+//     binary was built
+//  3. input.txt in the current directory
+type Input struct {
+	// Path is an explicit input file, or "-" to read standard input.
+	Path string
+	// Example picks the Nth example of the day, counting from 1.
+	Example int
+	// Strict makes malformed fields fail the day instead of reading as zero.
+	Strict bool
+	// options holds the options of every day with flags, bound to the flag
+	// set InputFlags was called with. Days without an entry use their
+	// defaults.
+	options map[int]any
+	// stdin is what a Path of "-" reads, os.Stdin when nil.
+	stdin io.Reader
+}
+
+// InputFlags registers the -input, -example and -strict flags on fs, along
+// with the flags of every day that has some.
+func InputFlags(fs *flag.FlagSet) *Input {
+	in := &Input{}
+	fs.StringVar(&in.Path, "input", "", "read the puzzle input from `file` (- for stdin)")
+	fs.IntVar(&in.Example, "example", 0, "run against the `N`th example from the puzzle text")
+	fs.BoolVar(&in.Strict, "strict", false, "fail on malformed input instead of reading bad fields as zero")
+	in.options = defineDayFlags(fs)
+	return in
+}
+
+// Lines returns the input lines for day.
+func (in Input) Lines(day int) ([]string, error) {
+	switch {
+	case in.Path != "" && in.Example != 0:
+		return nil, errors.New("-input and -example can't be used together")
+	case in.Path == "-":
+		if in.stdin != nil {
+			return helper.ReadLines(in.stdin)
+		}
+		return helper.ReadLines(os.Stdin)
+	case in.Path != "":
+		return helper.ReadFileLineByLine(in.Path)
+	case in.Example != 0:
+		fixtures := Examples(day)
+		if in.Example < 1 || in.Example > len(fixtures) {
+			return nil, fmt.Errorf("day %d has %d examples, no example %d", day, len(fixtures), in.Example)
+		}
+		return fixtures[in.Example-1].Input, nil
+	}
+	return helper.ReadFileLineByLine(defaultInputPath(day))
+}
+
+func defaultInputPath(day int) string {
+	candidates := []string{filepath.Join(fmt.Sprintf("day%d", day), "input.txt")}
+	if dir, ok := packageDirs[day]; ok {
+		candidates = append(candidates, filepath.Join(dir, "input.txt"))
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return "input.txt"
+}
@@ -0,0 +1,100 @@
+//go:build go1.24
+
+package runner
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aoc2024/fixture"
+)
+
+func TestLinesStdin(t *testing.T) {
+	in := Input{Path: "-", stdin: strings.NewReader("1 2\n3 4\n")}
+	lines, err := in.Lines(testDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"1 2", "3 4"}; !slices.Equal(lines, want) {
+		t.Errorf("got %q, want %q", lines, want)
+	}
+}
+
+func TestLinesPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "puzzle.txt")
+	writeFile(t, path, "a\nb\n")
+	lines, err := Input{Path: path}.Lines(testDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(lines, want) {
+		t.Errorf("got %q, want %q", lines, want)
+	}
+}
+
+func TestLinesExample(t *testing.T) {
+	RegisterExamples(testDay, []fixture.Fixture{{Name: "first", Input: []string{"x"}}})
+	t.Cleanup(func() { delete(examples, testDay) })
+
+	lines, err := Input{Example: 1}.Lines(testDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"x"}; !slices.Equal(lines, want) {
+		t.Errorf("got %q, want %q", lines, want)
+	}
+	for _, n := range []int{-1, 2} {
+		if _, err := (Input{Example: n}).Lines(testDay); err == nil {
+			t.Errorf("example %d of 1: no error", n)
+		}
+	}
+	if _, err := (Input{Path: "-", Example: 1}).Lines(testDay); err == nil {
+		t.Error("-input and -example together: no error")
+	}
+}
+
+func TestDefaultInputPath(t *testing.T) {
+	work, pkg := t.TempDir(), t.TempDir()
+	packageDirs[testDay] = pkg
+	t.Cleanup(func() { delete(packageDirs, testDay) })
+	t.Chdir(work)
+
+	writeFile(t, filepath.Join(work, "day99", "input.txt"), "day directory\n")
+	writeFile(t, filepath.Join(pkg, "input.txt"), "package directory\n")
+	writeFile(t, filepath.Join(work, "input.txt"), "working directory\n")
+
+	// Removing the file each step finds uncovers the next one.
+	steps := []struct{ want, path string }{
+		{"day directory", filepath.Join(work, "day99")},
+		{"package directory", filepath.Join(pkg, "input.txt")},
+		{"working directory", filepath.Join(work, "input.txt")},
+	}
+	for _, step := range steps {
+		lines, err := Input{}.Lines(testDay)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(lines) != 1 || lines[0] != step.want {
+			t.Fatalf("got %q, want input from the %s", lines, step.want)
+		}
+		if err := os.RemoveAll(step.path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := (Input{}).Lines(testDay); err == nil {
+		t.Error("no input.txt anywhere: no error")
+	}
+}
+
+func writeFile(t *testing.T, path, data string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,109 @@
+// Package runner keeps track of every day's solver and runs them against
+// their puzzle input.
+package runner
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+
+	"github.com/aoc2024/fixture"
+	"github.com/aoc2024/helper"
+	"github.com/aoc2024/solver"
+)
+
+var (
+	solvers  = make(map[int]solver.Solver)
+	examples = make(map[int][]fixture.Fixture)
+	// packageDirs holds the source directory of each day's package.
+	packageDirs = make(map[int]string)
+)
+
+// Register makes a day's solver available to the runner. It is meant to be
+// called from the init function of the day's package.
+func Register(day int, s solver.Solver) {
+	if _, exists := solvers[day]; exists {
+		panic(fmt.Sprintf("runner: day %d registered twice", day))
+	}
+	solvers[day] = s
+	if _, file, _, ok := runtime.Caller(1); ok {
+		packageDirs[day] = filepath.Dir(file)
+	}
+}
+
+// Lookup returns the solver registered for day.
+func Lookup(day int) (solver.Solver, bool) {
+	s, ok := solvers[day]
+	return s, ok
+}
+
+// RegisterExamples stores the example inputs from a day's puzzle text.
+func RegisterExamples(day int, fixtures []fixture.Fixture) {
+	examples[day] = append(examples[day], fixtures...)
+}
+
+// Examples returns the example inputs registered for day.
+func Examples(day int) []fixture.Fixture {
+	return examples[day]
+}
+
+// Days returns the registered day numbers in ascending order.
+func Days() []int {
+	days := make([]int, 0, len(solvers))
+	for day := range solvers {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+	return days
+}
+
+// Run solves day against in and writes both answers to w, after anything
+// else the day reports.
+func Run(w io.Writer, day int, in Input) error {
+	s, ok := Lookup(day)
+	if !ok {
+		return &UnknownDayError{Day: day}
+	}
+
+	input, err := in.Lines(day)
+	if err != nil {
+		return &InputError{Day: day, Err: err}
+	}
+
+	mode := helper.Lenient
+	if in.Strict {
+		mode = helper.Strict
+	}
+	part1, part2, err := solve(s, solver.Input{Lines: input, Mode: mode, Options: in.options[day], Log: w})
+	if err != nil {
+		return &SolveError{Day: day, Err: err}
+	}
+	fmt.Fprintf(w, "Part 1: %s\n", part1)
+	fmt.Fprintf(w, "Part 2: %s\n", part2)
+	return nil
+}
+
+// solve runs s and turns a panic into an error, so a bug in one day doesn't
+// take down a run of several days.
+func solve(s solver.Solver, in solver.Input) (part1, part2 solver.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return s.Solve(in)
+}
+
+// Main runs a single day and is what each day's command calls.
+func Main(day int) {
+	in := InputFlags(flag.CommandLine)
+	flag.Parse()
+	if err := Run(os.Stdout, day, *in); err != nil {
+		fmt.Fprintf(os.Stderr, "Error in day %d: %v\n", day, err)
+		os.Exit(ExitCode(err))
+	}
+}
@@ -0,0 +1,150 @@
+// Package solver defines the interface every day implements and the typed
+// answers it produces, so tooling can treat all days the same way.
+package solver
+
+import (
+	"io"
+	"math/big"
+	"strconv"
+
+	"github.com/aoc2024/helper"
+)
+
+// Kind tells which kind of answer a Result holds.
+type Kind int
+
+const (
+	KindNone Kind = iota // the part is not implemented
+	KindInt
+	KindUint
+	KindBig
+	KindText
+)
+
+// Result is the answer to one part of a puzzle. The zero value is a part
+// that is not implemented.
+type Result struct {
+	kind Kind
+	i    int64
+	u    uint64
+	b    *big.Int
+	s    string
+}
+
+func Int(v int) Result {
+	return Result{kind: KindInt, i: int64(v)}
+}
+
+func Int64(v int64) Result {
+	return Result{kind: KindInt, i: v}
+}
+
+func Uint64(v uint64) Result {
+	return Result{kind: KindUint, u: v}
+}
+
+// Big stores a copy of v so later changes to v don't leak into the answer.
+func Big(v *big.Int) Result {
+	return Result{kind: KindBig, b: new(big.Int).Set(v)}
+}
+
+func Text(s string) Result {
+	return Result{kind: KindText, s: s}
+}
+
+// NotImplemented marks a part that has no solution yet.
+func NotImplemented() Result {
+	return Result{}
+}
+
+func (r Result) Kind() Kind {
+	return r.kind
+}
+
+func (r Result) Implemented() bool {
+	return r.kind != KindNone
+}
+
+func (r Result) String() string {
+	switch r.kind {
+	case KindInt:
+		return strconv.FormatInt(r.i, 10)
+	case KindUint:
+		return strconv.FormatUint(r.u, 10)
+	case KindBig:
+		return r.b.String()
+	case KindText:
+		return r.s
+	}
+	return "not implemented"
+}
+
+// Equal reports whether both results hold the same answer. Numeric answers
+// are compared by value regardless of how they are stored, so Int(42) equals
+// Uint64(42), but a number never equals text, whatever it reads.
+func (r Result) Equal(other Result) bool {
+	if r.numeric() && other.numeric() {
+		return r.big().Cmp(other.big()) == 0
+	}
+	return r.kind == other.kind && r.String() == other.String()
+}
+
+func (r Result) numeric() bool {
+	return r.kind == KindInt || r.kind == KindUint || r.kind == KindBig
+}
+
+// big returns a numeric answer as a big.Int.
+func (r Result) big() *big.Int {
+	switch r.kind {
+	case KindInt:
+		return big.NewInt(r.i)
+	case KindUint:
+		return new(big.Int).SetUint64(r.u)
+	}
+	return r.b
+}
+
+// Input is what a solver is run on. The zero value holds no lines, parses
+// leniently, uses the day's default options and discards any log.
+type Input struct {
+	Lines []string
+	// Mode is how the solver's parsers treat fields they can't read.
+	Mode helper.ParseMode
+	// Options holds the day's own settings, as returned by the function
+	// the day registered with runner.RegisterFlags, or nil for the
+	// defaults.
+	Options any
+	// Log receives what a day reports besides its answers, such as the
+	// breakdowns its flags ask for. Nil discards it.
+	Log io.Writer
+}
+
+// Logger returns in.Log, or a writer discarding everything when it is nil.
+func (in Input) Logger() io.Writer {
+	if in.Log == nil {
+		return io.Discard
+	}
+	return in.Log
+}
+
+// OptionsOr returns the options in carries for a day whose options are a
+// *T, or def when it carries none.
+func OptionsOr[T any](in Input, def T) T {
+	if opts, ok := in.Options.(*T); ok && opts != nil {
+		return *opts
+	}
+	return def
+}
+
+// Solver solves both parts of a day's puzzle. Malformed input is reported
+// with a *helper.ParseError.
+type Solver interface {
+	Solve(in Input) (part1, part2 Result, err error)
+}
+
+// Func adapts a plain function to the Solver interface.
+type Func func(in Input) (part1, part2 Result, err error)
+
+func (f Func) Solve(in Input) (Result, Result, error) {
+	return f(in)
+}
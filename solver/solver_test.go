@@ -0,0 +1,33 @@
+package solver
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	huge, _ := new(big.Int).SetString("18446744073709551616", 10)
+	tests := []struct {
+		a, b Result
+		want bool
+	}{
+		{Int(42), Int(42), true},
+		{Int(42), Uint64(42), true},
+		{Int64(42), Big(big.NewInt(42)), true},
+		{Uint64(1<<63 + 1), Big(new(big.Int).SetUint64(1<<63 + 1)), true},
+		{Big(huge), Big(new(big.Int).Set(huge)), true},
+		{Int(-1), Uint64(1<<64 - 1), false},
+		{Int(42), Int(43), false},
+		{Text("42"), Int(42), false},
+		{Int(42), Text("42"), false},
+		{Text("42"), Text("42"), true},
+		{Text("not implemented"), NotImplemented(), false},
+		{NotImplemented(), NotImplemented(), true},
+		{NotImplemented(), Int(0), false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Equal(tt.b); got != tt.want {
+			t.Errorf("%v (kind %d).Equal(%v (kind %d)) = %v, want %v", tt.a, tt.a.Kind(), tt.b, tt.b.Kind(), got, tt.want)
+		}
+	}
+}